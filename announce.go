@@ -0,0 +1,311 @@
+package main
+
+import (
+	"sort"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// AnnouncementData is what the announcement templates are executed with.
+//
+// The "win" and "loss" blocks get a single player, "group" gets every
+// tracked player of the match and "remake" gets one or more.
+type AnnouncementData struct {
+	// Match is the full match-v5 response
+	Match Match
+	// Queue is the queue name, e.g. "Ranked Solo/Duo"
+	Queue string
+	// Duration of the game in minutes
+	Duration int
+	// Outcome is "normal", "remake", "early_surrender" or "surrender"
+	Outcome string
+	// Players are the tracked players in the match, ordered by team
+	Players []PlayerData
+	// Teams with at least one tracked player, blue side first
+	Teams []TeamData
+	// SameTeam is true when every tracked player was on the same team
+	SameTeam bool
+}
+
+// PlayerData is a tracked player in a match. Every Participant field and
+// method (.Kills, .ChampionName, .TotalPings, .DisplayName..) can be used
+// directly, plus the computed ones below.
+type PlayerData struct {
+	Participant
+	Account Account
+	// Team is the match-v5 team entry of the player, with bans and objectives
+	Team Team
+	// Result is "VICTORIA" or "DERROTA"
+	Result string
+	// Surrender is a label like " (FF a los 15)", empty when nobody gave up
+	Surrender string
+	// Phrase is the roast or praise picked for the player
+	Phrase string
+	// LP is the league change of ranked games, nil otherwise
+	LP *LeagueChange
+	// LPDelta is LP formatted like "+18 LP", empty when there is no change
+	LPDelta string
+	// TeamKills is the number of kills of the whole team
+	TeamKills int
+	// KP is the kill participation, from 0 to 100
+	KP float64
+	// KDA is (kills + assists) / deaths, deaths count as 1 when 0
+	KDA float64
+	// CSPerMin are the minions and monsters killed per minute
+	CSPerMin float64
+	// DamageShare is the percentage of the team damage to champions
+	DamageShare float64
+	// Timeline has the gold leads, first death and throws, nil when the
+	// timeline couldn't be fetched
+	Timeline *PlayerTimeline
+}
+
+// TeamData groups the tracked players of a team.
+type TeamData struct {
+	TeamId  int
+	Win     bool
+	Result  string
+	Players []PlayerData
+}
+
+var outcomeNames = map[MatchOutcome]string{
+	OutcomeNormal:         "normal",
+	OutcomeRemake:         "remake",
+	OutcomeEarlySurrender: "early_surrender",
+	OutcomeSurrender:      "surrender",
+}
+
+func resultName(win bool) string {
+	if win {
+		return "VICTORIA"
+	}
+
+	return "DERROTA"
+}
+
+// PhrasePicker returns the phrase of a player in a match.
+type PhrasePicker func(match Match, acc Account, p Participant) string
+
+// newAnnouncementData builds the template data of a match for the given
+// tracked players.
+func newAnnouncementData(
+	match Match,
+	timeline *MatchTimeline,
+	players []*trackedPlayer,
+	leagueChanges map[string]*LeagueChange,
+	pickPhrase PhrasePicker,
+) AnnouncementData {
+	data := AnnouncementData{
+		Match:    match,
+		Queue:    queueName(match.Info.QueueID),
+		Duration: match.Info.GameDuration / 60,
+	}
+
+	teamKills := map[int]int{}
+	teamDamage := map[int]int{}
+	for _, p := range match.Info.Participants {
+		teamKills[p.TeamID] += p.Kills
+		teamDamage[p.TeamID] += p.TotalDamageDealtToChampions
+	}
+
+	minutes := float64(match.Info.GameDuration) / 60
+
+	for _, p := range match.Info.Participants {
+		for _, player := range players {
+			if p.Puuid != player.account.Puuid {
+				continue
+			}
+
+			outcome := classifyMatch(match, p)
+			player := PlayerData{
+				Participant: p,
+				Account:     player.account,
+				Result:      resultName(p.Win),
+				Surrender:   surrenderLabel(outcome, p.Win),
+				Phrase:      pickPhrase(match, player.account, p),
+				LP:          leagueChanges[p.Puuid],
+				TeamKills:   teamKills[p.TeamID],
+				KDA:         float64(p.Kills+p.Assists) / float64(maxInt(p.Deaths, 1)),
+			}
+
+			for _, team := range match.Info.Teams {
+				if team.TeamID == p.TeamID {
+					player.Team = team
+				}
+			}
+
+			if player.LP != nil {
+				player.LPDelta = formatLPDelta(player.LP)
+			}
+
+			if timeline != nil {
+				stats := playerTimeline(*timeline, match, p)
+				player.Timeline = &stats
+			}
+
+			if teamKills[p.TeamID] > 0 {
+				player.KP = float64(p.Kills+p.Assists) * 100 / float64(teamKills[p.TeamID])
+			}
+
+			if teamDamage[p.TeamID] > 0 {
+				player.DamageShare = float64(p.TotalDamageDealtToChampions) * 100 / float64(teamDamage[p.TeamID])
+			}
+
+			if minutes > 0 {
+				player.CSPerMin = float64(p.TotalMinionsKilled+p.NeutralMinionsKilled) / minutes
+			}
+
+			if data.Outcome == "" {
+				data.Outcome = outcomeNames[outcome]
+			}
+
+			data.Players = append(data.Players, player)
+		}
+	}
+
+	sort.SliceStable(data.Players, func(i, j int) bool {
+		return data.Players[i].TeamID < data.Players[j].TeamID
+	})
+
+	for _, player := range data.Players {
+		if len(data.Teams) == 0 || data.Teams[len(data.Teams)-1].TeamId != player.TeamID {
+			data.Teams = append(data.Teams, TeamData{
+				TeamId: player.TeamID,
+				Win:    player.Win,
+				Result: resultName(player.Win),
+			})
+		}
+
+		team := &data.Teams[len(data.Teams)-1]
+		team.Players = append(team.Players, player)
+	}
+
+	data.SameTeam = len(data.Teams) == 1
+
+	return data
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// announceBlock picks the template block for the announcement.
+func announceBlock(data AnnouncementData) string {
+	switch {
+	case data.Outcome == outcomeNames[OutcomeRemake]:
+		return "remake"
+	case len(data.Players) > 1:
+		return "group"
+	case data.Players[0].Win:
+		return "win"
+	default:
+		return "loss"
+	}
+}
+
+// announce renders the match with the template of the group and sends it.
+func (c *LeviClient) announce(
+	group types.JID,
+	match Match,
+	timeline *MatchTimeline,
+	players []*trackedPlayer,
+	leagueChanges map[string]*LeagueChange,
+) {
+	pickPhrase := func(match Match, acc Account, p Participant) string {
+		return c.matchPhrase(group, match, acc, p)
+	}
+	data := newAnnouncementData(match, timeline, players, leagueChanges, pickPhrase)
+
+	if len(data.Players) == 0 {
+		return
+	}
+
+	settings := c.groupSettings(group)
+	block := announceBlock(data)
+
+	if block == "remake" && settings.SkipRemakes {
+		return
+	}
+
+	msg, err := c.templates.Render(settings.Template, block, data)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not render %s announcement of %s: %s", block, match.Metadata.MatchID, err)
+		return
+	}
+
+	c.wppClient.Log.Infof("Announcing %s of %s to %s", block, match.Metadata.MatchID, group)
+
+	// answer the "in game" message so the result shows up linked to it
+	live, quoted := c.liveGame(group, match.Metadata.MatchID)
+	if quoted {
+		c.db.Unscoped().Delete(&live)
+	}
+
+	if images := c.announcementImages(settings, block, timeline, data); len(images) > 0 {
+		// the text goes as the caption of the first one, a failed upload
+		// falls back to the plain text
+		if c.SendImage(group, images[0], msg, live.MessageId, live.Text) != "" {
+			for _, image := range images[1:] {
+				c.SendImage(group, image, "", "", "")
+			}
+			return
+		}
+	}
+
+	if quoted {
+		c.SendQuote(group, msg, live.MessageId, live.Text)
+		return
+	}
+
+	c.SendText(group, msg)
+}
+
+// announcementImages renders a scorecard per player when the group wants
+// them, or else the gold chart of the announced players' team. Remakes and
+// groups that turned both off get none.
+func (c *LeviClient) announcementImages(
+	settings GroupSettings,
+	block string,
+	timeline *MatchTimeline,
+	data AnnouncementData,
+) [][]byte {
+	if block == "remake" {
+		return nil
+	}
+
+	matchId := data.Match.Metadata.MatchID
+	var images [][]byte
+
+	if settings.Scorecards {
+		for _, player := range data.Players {
+			card, err := renderScorecard(data, player, c.ddragon)
+
+			if err != nil {
+				c.wppClient.Log.Errorf("Could not render the scorecard of %s in %s: %s", player.Account.RiotId(), matchId, err)
+				continue
+			}
+
+			images = append(images, card)
+		}
+
+		return images
+	}
+
+	if settings.SkipCharts || timeline == nil {
+		return nil
+	}
+
+	chart, err := renderGoldChart(*timeline, data.Match, data.Players[0].TeamID)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not render the chart of %s: %s", matchId, err)
+		return nil
+	}
+
+	return append(images, chart)
+}
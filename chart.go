@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	chartWidth  = 800
+	chartHeight = 400
+	// space around the plot for the labels and the markers
+	chartMarginLeft   = 60
+	chartMarginRight  = 20
+	chartMarginTop    = 64
+	chartMarginBottom = 48
+)
+
+var (
+	chartBackground = color.RGBA{0x1e, 0x21, 0x27, 0xff}
+	chartGrid       = color.RGBA{0x3a, 0x3f, 0x4a, 0xff}
+	chartText       = color.RGBA{0xdd, 0xdd, 0xdd, 0xff}
+	chartAhead      = color.RGBA{0x2d, 0x7f, 0xd8, 0xff}
+	chartBehind     = color.RGBA{0xd8, 0x3c, 0x3c, 0xff}
+	chartAheadFill  = color.NRGBA{0x2d, 0x7f, 0xd8, 0x50}
+	chartBehindFill = color.NRGBA{0xd8, 0x3c, 0x3c, 0x50}
+	chartXp         = color.RGBA{0xe8, 0xc5, 0x47, 0xff}
+)
+
+// Letters drawn for the objectives, anything else is a kill.
+var objectiveLabels = map[string]string{
+	"DRAGON":             "D",
+	"BARON_NASHOR":       "B",
+	"RIFTHERALD":         "H",
+	"HORDE":              "V",
+	"ATAKHAN":            "A",
+	"TOWER_BUILDING":     "T",
+	"INHIBITOR_BUILDING": "I",
+}
+
+type chartMarker struct {
+	timestamp int64
+	label     string
+	// ally is true when the team of the chart got it
+	ally bool
+}
+
+// renderGoldChart draws the team gold and xp difference over the game from
+// the point of view of teamId, with kills and objectives marked above the
+// plot for the team and below it for the enemies. The output only depends
+// on its input so the same match always renders the same PNG.
+func renderGoldChart(timeline MatchTimeline, match Match, teamId int) ([]byte, error) {
+	gold := timeline.GoldDiffs(match, teamId)
+	xp := timeline.XpDiffs(match, teamId)
+
+	if len(gold) < 2 {
+		return nil, fmt.Errorf("chart: timeline of %s has no frames", match.Metadata.MatchID)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, chartWidth, chartHeight))
+	draw.Draw(img, img.Bounds(), &image.Uniform{chartBackground}, image.Point{}, draw.Src)
+
+	plot := image.Rect(chartMarginLeft, chartMarginTop, chartWidth-chartMarginRight, chartHeight-chartMarginBottom)
+
+	// the scale is symmetric so zero is always in the middle
+	maxAbs := 1000
+	for i := range gold {
+		maxAbs = maxInt(maxAbs, maxInt(abs(gold[i]), abs(xp[i])))
+	}
+	maxAbs = (maxAbs + 999) / 1000 * 1000
+
+	duration := timeline.Info.Frames[len(timeline.Info.Frames)-1].Timestamp
+	x := func(timestamp int64) int {
+		return plot.Min.X + int(timestamp*int64(plot.Dx())/maxInt64(duration, 1))
+	}
+	y := func(value int) int {
+		return plot.Min.Y + plot.Dy()/2 - value*plot.Dy()/2/maxAbs
+	}
+	zero := y(0)
+
+	// about five grid lines on each side, at round thousands
+	step := maxInt(1000, maxAbs/5/1000*1000)
+	for v := maxAbs / step * step; v >= -maxAbs; v -= step {
+		c := chartGrid
+		if v == 0 {
+			c = chartText
+		}
+		hline(img, plot.Min.X, plot.Max.X, y(v), c)
+		drawText(img, 4, y(v)+4, formatGoldDiff(v), chartText)
+	}
+
+	for minute := int64(0); minute*60000 <= duration; minute += 5 {
+		vline(img, x(minute*60000), plot.Min.Y, plot.Max.Y, chartGrid)
+		drawText(img, x(minute*60000)-7, plot.Max.Y+42, fmt.Sprintf("%dm", minute), chartText)
+	}
+
+	timestamps := make([]int64, len(gold))
+	for i, frame := range timeline.Info.Frames {
+		timestamps[i] = frame.Timestamp
+	}
+
+	// fill between the gold line and zero, blue when ahead and red behind
+	for px := plot.Min.X; px < plot.Max.X; px++ {
+		value := interpolate(timestamps, gold, int64(px-plot.Min.X)*maxInt64(duration, 1)/int64(plot.Dx()))
+		fill, top, bottom := chartAheadFill, y(value), zero
+		if value < 0 {
+			fill, top, bottom = chartBehindFill, zero, y(value)
+		}
+		vline(img, px, top, bottom, fill)
+	}
+
+	for i := 1; i < len(gold); i++ {
+		line(img, x(timestamps[i-1]), y(xp[i-1]), x(timestamps[i]), y(xp[i]), chartXp)
+	}
+
+	for i := 1; i < len(gold); i++ {
+		c := chartAhead
+		if gold[i] < 0 {
+			c = chartBehind
+		}
+		line(img, x(timestamps[i-1]), y(gold[i-1]), x(timestamps[i]), y(gold[i]), c)
+		line(img, x(timestamps[i-1]), y(gold[i-1])+1, x(timestamps[i]), y(gold[i])+1, c)
+	}
+
+	for _, marker := range chartMarkers(timeline, match, teamId) {
+		mx := x(marker.timestamp)
+		if marker.label == "" {
+			if marker.ally {
+				dot(img, mx, plot.Min.Y-5, chartAhead)
+			} else {
+				dot(img, mx, plot.Max.Y+5, chartBehind)
+			}
+			continue
+		}
+
+		if marker.ally {
+			drawText(img, mx-3, plot.Min.Y-14, marker.label, chartAhead)
+		} else {
+			drawText(img, mx-3, plot.Max.Y+26, marker.label, chartBehind)
+		}
+	}
+
+	drawText(img, chartMarginLeft, 16, "Diferencia de oro (linea) y experiencia (amarillo)", chartText)
+	drawText(img, chartMarginLeft, 30, "o kills, D dragon, B baron, H heraldo, V larvas, T torre, I inhibidor", chartText)
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// chartMarkers returns the kills and objectives of the game.
+func chartMarkers(timeline MatchTimeline, match Match, teamId int) []chartMarker {
+	teams := map[int]int{}
+	for _, p := range match.Info.Participants {
+		teams[p.ParticipantID] = p.TeamID
+	}
+
+	var markers []chartMarker
+	objective := func(timestamp int64, kind string, ally bool) {
+		// unknown objectives are skipped, without a letter they would look
+		// like kills
+		if label, ok := objectiveLabels[kind]; ok {
+			markers = append(markers, chartMarker{timestamp, label, ally})
+		}
+	}
+
+	for _, frame := range timeline.Info.Frames {
+		for _, event := range frame.Events {
+			switch data := event.Data.(type) {
+			case *ChampionKillEvent:
+				// executions by towers or minions have no killer, the team
+				// of the victim tells who lost it
+				markers = append(markers, chartMarker{data.Timestamp, "", teams[data.VictimID] != teamId})
+			case *EliteMonsterKillEvent:
+				objective(data.Timestamp, data.MonsterType, data.KillerTeamID == teamId)
+			case *BuildingKillEvent:
+				// teamId of a building kill is the team that lost the building
+				objective(data.Timestamp, data.BuildingType, data.TeamID != teamId)
+			}
+		}
+	}
+
+	return markers
+}
+
+// interpolate returns the value at t of the series sampled at timestamps.
+func interpolate(timestamps []int64, values []int, t int64) int {
+	for i := 1; i < len(timestamps); i++ {
+		if t <= timestamps[i] {
+			span := maxInt64(timestamps[i]-timestamps[i-1], 1)
+			return values[i-1] + int(int64(values[i]-values[i-1])*(t-timestamps[i-1])/span)
+		}
+	}
+
+	return values[len(values)-1]
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func hline(img *image.RGBA, x0, x1, y int, c color.Color) {
+	for x := x0; x < x1; x++ {
+		blend(img, x, y, c)
+	}
+}
+
+func vline(img *image.RGBA, x, y0, y1 int, c color.Color) {
+	for y := y0; y < y1; y++ {
+		blend(img, x, y, c)
+	}
+}
+
+// line draws a one pixel line with Bresenham's algorithm.
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		blend(img, x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func dot(img *image.RGBA, x, y int, c color.Color) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			if dx*dx+dy*dy <= 5 {
+				blend(img, x+dx, y+dy, c)
+			}
+		}
+	}
+}
+
+// blend paints a pixel honoring the alpha of c.
+func blend(img *image.RGBA, x, y int, c color.Color) {
+	if !(image.Point{x, y}.In(img.Bounds())) {
+		return
+	}
+
+	draw.Draw(img, image.Rect(x, y, x+1, y+1), &image.Uniform{c}, image.Point{}, draw.Over)
+}
+
+// drawText writes with the basic 7x13 font, y is the baseline.
+func drawText(img *image.RGBA, x, y int, text string, c color.Color) {
+	d := font.Drawer{
+		Dst:  img,
+		Src:  &image.Uniform{c},
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
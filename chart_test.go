@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image/color"
+	"image/png"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// loadTimeline reads a recorded match-v5 timeline from testdata.
+func loadTimeline(t *testing.T, matchId string) MatchTimeline {
+	t.Helper()
+
+	content, err := os.ReadFile("testdata/timeline_" + matchId + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var timeline MatchTimeline
+	if err := json.Unmarshal(content, &timeline); err != nil {
+		t.Fatal(err)
+	}
+
+	return timeline
+}
+
+// golden compares the PNG got with a checked-in file of testdata pixel by
+// pixel, the encoded bytes change with the Go version. go test -update
+// writes it instead.
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := "testdata/" + name
+	if *update {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := imageDiff(t, got, want); diff != "" {
+		os.WriteFile(path+".got", got, 0644)
+		t.Errorf("%s doesn't match the golden file, %s, the output is in %s.got", name, diff, path)
+	}
+}
+
+// imageDiff describes the first difference between two PNGs, empty when
+// they have the same pixels.
+func imageDiff(t *testing.T, got, want []byte) string {
+	t.Helper()
+
+	gotImg, err := png.Decode(bytes.NewReader(got))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantImg, err := png.Decode(bytes.NewReader(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gotImg.Bounds() != wantImg.Bounds() {
+		return fmt.Sprintf("got bounds %v, want %v", gotImg.Bounds(), wantImg.Bounds())
+	}
+
+	bounds := gotImg.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			g := color.NRGBAModel.Convert(gotImg.At(x, y))
+			w := color.NRGBAModel.Convert(wantImg.At(x, y))
+			if g != w {
+				return fmt.Sprintf("pixel %d,%d is %v, want %v", x, y, g, w)
+			}
+		}
+	}
+
+	return ""
+}
+
+func TestRenderGoldChart(t *testing.T) {
+	match := loadMatch(t, "EUW1_6612345678")
+	timeline := loadTimeline(t, "EUW1_6612345678")
+
+	for _, teamId := range []int{100, 200} {
+		chart, err := renderGoldChart(timeline, match, teamId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden(t, fmt.Sprintf("chart_EUW1_6612345678_%d.png", teamId), chart)
+	}
+}
+
+func TestRenderGoldChartWithoutFrames(t *testing.T) {
+	match := loadMatch(t, "EUW1_6612345678")
+
+	if _, err := renderGoldChart(MatchTimeline{}, match, 100); err == nil {
+		t.Fatal("renderGoldChart of an empty timeline didn't fail")
+	}
+}
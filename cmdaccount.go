@@ -0,0 +1,215 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+type addAccountArgs struct {
+	RiotId   string
+	Platform string
+}
+
+func init() {
+	registerCommand(&Command{
+		Name:        "addaccount",
+		Aliases:     []string{"add"},
+		Usage:       ".addaccount <nombre#tag> [region]",
+		Description: "Empieza a seguir las partidas de una cuenta en este grupo",
+		Role:        RoleAdmin,
+		Parse:       parseAddAccountArgs,
+		Handler:     addAccountCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "removeaccount",
+		Aliases:     []string{"remove"},
+		Usage:       ".removeaccount <nombre#tag>",
+		Description: "Deja de seguir una cuenta en este grupo",
+		Role:        RoleAdmin,
+		Parse:       requireArgs,
+		Handler:     removeAccountCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "accounts",
+		Aliases:     []string{"cuentas"},
+		Usage:       ".accounts",
+		Description: "Lista las cuentas seguidas en este grupo con su rango y ultima partida",
+		Role:        RoleMember,
+		Handler:     accountsCommand,
+	})
+}
+
+// parseAddAccountArgs accepts names with spaces, the region is optional and
+// goes last: .addaccount Some Name#EUW euw
+func parseAddAccountArgs(args []string) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, errUsage
+	}
+
+	platform := defaultPlatform
+	if len(args) > 1 {
+		if p, ok := parsePlatform(args[len(args)-1]); ok {
+			platform = p
+			args = args[:len(args)-1]
+		}
+	}
+
+	return addAccountArgs{strings.Join(args, " "), platform}, nil
+}
+
+func addAccountCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(addAccountArgs)
+	acc, err := c.retrievePlayerInfo(args.Platform, args.RiotId)
+
+	if err != nil {
+		return fmt.Errorf("no se pudo añadir %s: %s", args.RiotId, describeRiotError(err))
+	}
+
+	c.cacheMu.Lock()
+	_, tracked := c.playerCache[acc.Puuid]
+	c.cacheMu.Unlock()
+
+	// another group already follows it, there is nothing to poll again
+	if !tracked {
+		if err := c.trackAccount(acc); err != nil {
+			return fmt.Errorf("no se pudo añadir %s: %s", args.RiotId, describeRiotError(err))
+		}
+	}
+
+	if !c.subscribe(ctx.Chat, acc) {
+		return fmt.Errorf("este grupo ya sigue a %s", acc.RiotId())
+	}
+
+	c.Reply(ctx, fmt.Sprintf(
+		"Siguiendo a %s (%s), ya son %d cuentas. Usa .accounts para verlas",
+		acc.RiotId(),
+		strings.ToUpper(acc.Platform),
+		len(c.groupAccounts(ctx.Chat)),
+	))
+	return nil
+}
+
+// trackAccount starts polling an account from its last match.
+func (c *LeviClient) trackAccount(acc Account) error {
+	matchId, err := c.lolClient.GetLastMatchId(acc.Platform, acc.Puuid)
+
+	if err != nil && !errors.Is(err, ErrNoMatches) {
+		return err
+	}
+
+	c.db.Create(&acc)
+	c.wppClient.Log.Infof("Added account: %+v\n", acc)
+
+	state := newTrackingState(acc, matchId)
+	c.db.Where(TrackingState{Puuid: acc.Puuid}).Assign(state).FirstOrCreate(&state)
+
+	c.cacheMu.Lock()
+	c.playerCache[acc.Puuid] = &trackedPlayer{acc, state}
+	c.cacheMu.Unlock()
+
+	go func() {
+		c.snapshotLeagues(acc)
+		c.backfillMatches(acc)
+	}()
+
+	return nil
+}
+
+func removeAccountCommand(c *LeviClient, ctx *CommandContext) error {
+	name := strings.Join(ctx.Args, " ")
+	acc, ok := c.findAccount(ctx.Chat, name)
+
+	if !ok {
+		return fmt.Errorf("no sigo a ninguna cuenta llamada %s", name)
+	}
+
+	// the account is only dropped once no group follows it
+	if c.unsubscribe(ctx.Chat, acc) == 0 {
+		c.untrackAccount(acc)
+		c.db.Unscoped().Delete(&acc)
+
+		c.wppClient.Log.Infof("Removed account: %+v\n", acc)
+	}
+
+	c.Reply(ctx, fmt.Sprintf("Ya no sigo a %s", acc.RiotId()))
+	return nil
+}
+
+func accountsCommand(c *LeviClient, ctx *CommandContext) error {
+	accs := c.groupAccounts(ctx.Chat)
+
+	if len(accs) == 0 {
+		c.Reply(ctx, "No sigo a nadie, usa .addaccount <nombre#tag> [region]")
+		return nil
+	}
+
+	lines := []string{fmt.Sprintf("Cuentas seguidas (%d):", len(accs))}
+	for _, acc := range accs {
+		rank := "sin rankear"
+		leagues, err := c.lolClient.GetLeagueBySummonerId(acc.Platform, acc.Id)
+		if err != nil {
+			rank = "rango desconocido"
+		} else if league, ok := findLeague(leagues, soloQueueType); ok {
+			rank = formatRank(league)
+		}
+
+		lastGame := "sin partidas"
+		if played, ok := c.lastPlayed(acc.Puuid); ok {
+			lastGame = "ultima partida " + formatAgo(played)
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			"- %s (%s) · %s · %s",
+			acc.RiotId(),
+			strings.ToUpper(acc.Platform),
+			rank,
+			lastGame,
+		))
+	}
+
+	c.Reply(ctx, strings.Join(lines, "\n"))
+	return nil
+}
+
+// findAccount looks up an account followed by the group by Riot ID, game
+// name or the old summoner name, ignoring case.
+func (c *LeviClient) findAccount(group types.JID, name string) (Account, bool) {
+	accs := c.groupAccounts(group)
+
+	name = strings.TrimSpace(name)
+	for _, acc := range accs {
+		if strings.EqualFold(acc.RiotId(), name) || strings.EqualFold(acc.Name, name) {
+			return acc, true
+		}
+	}
+
+	for _, acc := range accs {
+		if acc.GameName != "" && strings.EqualFold(acc.GameName, name) {
+			return acc, true
+		}
+	}
+
+	return Account{}, false
+}
+
+// formatAgo returns things like "hace 5 min" or "hace 3 dias".
+func formatAgo(t time.Time) string {
+	d := time.Since(t)
+
+	switch {
+	case d < time.Minute:
+		return "ahora mismo"
+	case d < time.Hour:
+		return fmt.Sprintf("hace %d min", int(d.Minutes()))
+	case d < time.Hour*24:
+		return fmt.Sprintf("hace %d h", int(d.Hours()))
+	default:
+		return fmt.Sprintf("hace %d dias", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func init() {
+	registerCommand(&Command{
+		Name:        "live",
+		Aliases:     []string{"partida"},
+		Usage:       ".live <nombre#tag> [region]",
+		Description: "Muestra la partida que esta jugando alguien ahora mismo",
+		Role:        RoleMember,
+		Parse:       parseAddAccountArgs,
+		Handler:     liveCommand,
+	})
+}
+
+func liveCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(addAccountArgs)
+
+	// tracked accounts are found by name without asking Riot
+	acc, ok := c.findAccount(ctx.Chat, args.RiotId)
+	if !ok {
+		var err error
+		if acc, err = c.retrievePlayerInfo(args.Platform, args.RiotId); err != nil {
+			return fmt.Errorf("no encuentro a %s: %s", args.RiotId, describeRiotError(err))
+		}
+	}
+
+	game, err := c.lolClient.GetActiveGameBySummoner(acc.Platform, acc.Id)
+
+	if errors.Is(err, ErrNotFound) {
+		c.Reply(ctx, fmt.Sprintf("%s no esta en ninguna partida ahora mismo", acc.RiotId()))
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.Reply(ctx, c.formatCurrentGame(game, acc))
+	return nil
+}
+
+// formatCurrentGame shows both teams with their summoner spells and bans,
+// plus the Solo/Duo rank of the opponents of acc.
+func (c *LeviClient) formatCurrentGame(game CurrentGame, acc Account) string {
+	elapsed := "cargando"
+	if game.GameStartTime > 0 {
+		elapsed = fmt.Sprintf("%d min", int(time.Since(time.Unix(game.GameStartTime/1000, 0)).Minutes()))
+	}
+
+	homeTeam := 0
+	for _, p := range game.Participants {
+		if p.SummonerID == acc.Id {
+			homeTeam = p.TeamID
+		}
+	}
+
+	lines := []string{fmt.Sprintf("*%s* · %s · %s", acc.RiotId(), queueName(game.GameQueueConfigID), elapsed)}
+	ranks := map[string]string{}
+
+	for _, teamId := range []int{100, 200} {
+		lines = append(lines, "", teamNames[teamId]+":")
+
+		for _, p := range game.Participants {
+			if p.TeamID != teamId {
+				continue
+			}
+
+			line := fmt.Sprintf(
+				" %s (%s, %s) - %s",
+				c.ddragon.ChampionName(p.ChampionID),
+				c.ddragon.SpellName(p.Spell1ID),
+				c.ddragon.SpellName(p.Spell2ID),
+				p.DisplayName(),
+			)
+
+			if p.TeamID != homeTeam && !p.Bot {
+				line += fmt.Sprintf(" (%s)", c.soloRank(game.PlatformID, p.SummonerID, ranks))
+			}
+
+			lines = append(lines, line)
+		}
+
+		var bans []string
+		for _, ban := range game.BannedChampions {
+			// -1 is a skipped ban
+			if ban.TeamID == teamId && ban.ChampionID > 0 {
+				bans = append(bans, c.ddragon.ChampionName(ban.ChampionID))
+			}
+		}
+
+		if len(bans) > 0 {
+			lines = append(lines, " Bans: "+strings.Join(bans, ", "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
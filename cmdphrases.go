@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type addPhraseArgs struct {
+	Category   string
+	Text       string
+	Champion   string
+	Player     string
+	DeathsOver *int
+	Weight     int
+}
+
+func init() {
+	registerCommand(&Command{
+		Name:        "addphrase",
+		Aliases:     []string{"addfrase"},
+		Usage:       `.addphrase <categoria> "texto" [champion=Yasuo] [player=nombre#tag] [deaths>N] [weight=N]`,
+		Description: "Añade una frase a los anuncios del grupo, categorias: " + strings.Join(phraseCategories, ", "),
+		Role:        RoleAdmin,
+		Parse:       parseAddPhraseArgs,
+		Handler:     addPhraseCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "delphrase",
+		Aliases:     []string{"delfrase"},
+		Usage:       ".delphrase <id>",
+		Description: "Borra una frase del grupo, las compartidas solo el dueño del bot",
+		Role:        RoleAdmin,
+		Parse:       requireArgs,
+		Handler:     delPhraseCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "phrases",
+		Aliases:     []string{"frases"},
+		Usage:       ".phrases [categoria]",
+		Description: "Lista las frases del grupo y las compartidas con su id y condiciones",
+		Role:        RoleMember,
+		Handler:     phrasesCommand,
+	})
+}
+
+// parseAddPhraseArgs takes the category first, the conditions from the end
+// and joins the rest as the text so quotes are optional.
+func parseAddPhraseArgs(args []string) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, errUsage
+	}
+
+	parsed := addPhraseArgs{Category: strings.ToLower(args[0]), Weight: 1}
+	if !isPhraseCategory(parsed.Category) {
+		return nil, fmt.Errorf("no existe la categoria %s, usa: %s", args[0], strings.Join(phraseCategories, ", "))
+	}
+
+	i := len(args)
+	for ; i > 2; i-- {
+		arg := args[i-1]
+		lower := strings.ToLower(arg)
+
+		switch {
+		case strings.HasPrefix(lower, "champion="):
+			parsed.Champion = arg[len("champion="):]
+		case strings.HasPrefix(lower, "player="):
+			parsed.Player = arg[len("player="):]
+		case strings.HasPrefix(lower, "deaths>"):
+			deaths, err := strconv.Atoi(arg[len("deaths>"):])
+			if err != nil || deaths < 0 {
+				return nil, fmt.Errorf("%s no es un numero de muertes valido", arg)
+			}
+			parsed.DeathsOver = &deaths
+		case strings.HasPrefix(lower, "weight="):
+			weight, err := strconv.Atoi(arg[len("weight="):])
+			if err != nil || weight < 1 {
+				return nil, fmt.Errorf("el peso tiene que ser un numero mayor que 0")
+			}
+			parsed.Weight = weight
+		default:
+			parsed.Text = strings.Join(args[1:i], " ")
+			return parsed, nil
+		}
+	}
+
+	parsed.Text = strings.Join(args[1:i], " ")
+	return parsed, nil
+}
+
+func addPhraseCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(addPhraseArgs)
+
+	phrase := Phrase{
+		GroupJID:   ctx.Chat.String(),
+		Category:   args.Category,
+		Text:       args.Text,
+		Champion:   args.Champion,
+		DeathsOver: args.DeathsOver,
+		Weight:     args.Weight,
+	}
+
+	if args.Player != "" {
+		acc, ok := c.findAccount(ctx.Chat, args.Player)
+		if !ok {
+			return fmt.Errorf("no sigo a ninguna cuenta llamada %s", args.Player)
+		}
+		phrase.Puuid = acc.Puuid
+	}
+
+	if err := c.db.Create(&phrase).Error; err != nil {
+		return err
+	}
+
+	c.Reply(ctx, fmt.Sprintf("Frase %d añadida a %s", phrase.ID, phrase.Category))
+	return nil
+}
+
+func delPhraseCommand(c *LeviClient, ctx *CommandContext) error {
+	id, err := strconv.Atoi(strings.TrimPrefix(ctx.Args[0], "#"))
+	if err != nil {
+		return errUsage
+	}
+
+	var phrase Phrase
+	if c.groupPhrases(ctx.Chat).Limit(1).Find(&phrase, id).RowsAffected == 0 {
+		return fmt.Errorf("no existe la frase %d", id)
+	}
+
+	// shared phrases show up in every group
+	if phrase.GroupJID == "" && ctx.Role < RoleOwner {
+		return fmt.Errorf("la frase %d es compartida, solo el dueño del bot puede borrarla", id)
+	}
+
+	if err := c.db.Unscoped().Delete(&phrase).Error; err != nil {
+		return err
+	}
+
+	c.Reply(ctx, fmt.Sprintf("Frase %d borrada", id))
+	return nil
+}
+
+func phrasesCommand(c *LeviClient, ctx *CommandContext) error {
+	query := c.groupPhrases(ctx.Chat).Order("category, id")
+
+	if len(ctx.Args) > 0 {
+		category := strings.ToLower(ctx.Args[0])
+		if !isPhraseCategory(category) {
+			return fmt.Errorf("no existe la categoria %s, usa: %s", ctx.Args[0], strings.Join(phraseCategories, ", "))
+		}
+		query = query.Where("category = ?", category)
+	}
+
+	var phrases []Phrase
+	query.Find(&phrases)
+
+	if len(phrases) == 0 {
+		c.Reply(ctx, "No hay frases")
+		return nil
+	}
+
+	var accs []Account
+	c.db.Find(&accs)
+
+	players := map[string]string{}
+	for _, acc := range accs {
+		players[acc.Puuid] = acc.RiotId()
+	}
+
+	var lines []string
+	category := ""
+	for _, phrase := range phrases {
+		if phrase.Category != category {
+			category = phrase.Category
+			lines = append(lines, fmt.Sprintf("*%s*:", category))
+		}
+
+		player := players[phrase.Puuid]
+		if phrase.Puuid != "" && player == "" {
+			player = "?"
+		}
+
+		line := fmt.Sprintf("%d. %s", phrase.ID, phrase.Text)
+		if conditions := phrase.Conditions(player); conditions != "" {
+			line += fmt.Sprintf(" [%s]", conditions)
+		}
+		if phrase.GroupJID == "" {
+			line += " (compartida)"
+		}
+		lines = append(lines, line)
+	}
+
+	c.Reply(ctx, strings.Join(lines, "\n"))
+	return nil
+}
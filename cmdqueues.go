@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type muteArgs struct {
+	Name   string
+	Queues []string
+}
+
+func init() {
+	registerCommand(&Command{
+		Name:        "queues",
+		Aliases:     []string{"colas"},
+		Usage:       ".queues [filtro]",
+		Description: "Muestra o cambia que colas se anuncian, p.ej. .queues ranked o .queues all -custom",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     queuesCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "remakes",
+		Usage:       ".remakes [on|off]",
+		Description: "Muestra o cambia si se anuncian los remakes",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     remakesCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "mute",
+		Usage:       ".mute <nombre#tag> <cola...>",
+		Description: "Deja de anunciar las partidas de una cuenta en esas colas",
+		Role:        RoleModerator,
+		Parse:       parseMuteArgs,
+		Handler:     muteCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "unmute",
+		Usage:       ".unmute <nombre#tag> [cola...]",
+		Description: "Vuelve a anunciar las colas silenciadas de una cuenta, sin colas todas",
+		Role:        RoleModerator,
+		Parse:       parseMuteArgs,
+		Handler:     unmuteCommand,
+	})
+}
+
+func queuesCommand(c *LeviClient, ctx *CommandContext) error {
+	if len(ctx.Args) == 0 {
+		filter := c.groupSettings(ctx.Chat).QueueFilter
+		if filter == "" {
+			filter = "all"
+		}
+
+		c.Reply(ctx, fmt.Sprintf(
+			"Colas anunciadas: %s\nPuedes usar: %s, ids de cola y - para excluir",
+			filter,
+			queueGroupNames(),
+		))
+		return nil
+	}
+
+	filter, err := parseQueueFilter(ctx.Args)
+	if err != nil {
+		return err
+	}
+
+	settings := c.groupSettings(ctx.Chat)
+	c.db.Model(&settings).Update("queue_filter", filter)
+
+	c.Reply(ctx, fmt.Sprintf("Ahora se anuncian: %s", filter))
+	return nil
+}
+
+// parseMuteArgs takes the queues from the end so names don't need quotes.
+func parseMuteArgs(args []string) (interface{}, error) {
+	i := len(args)
+	for i > 1 && isQueueToken(args[i-1]) && !strings.HasPrefix(args[i-1], "-") {
+		i--
+	}
+
+	if i == 0 {
+		return nil, errUsage
+	}
+
+	return muteArgs{strings.Join(args[:i], " "), args[i:]}, nil
+}
+
+func muteCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(muteArgs)
+	if len(args.Queues) == 0 {
+		return errUsage
+	}
+
+	acc, ok := c.findAccount(ctx.Chat, args.Name)
+	if !ok {
+		return fmt.Errorf("no sigo a ninguna cuenta llamada %s", args.Name)
+	}
+
+	sub, _ := c.subscription(ctx.Chat, acc)
+
+	muted := strings.Fields(string(sub.MutedQueues))
+	for _, queue := range args.Queues {
+		if !containsString(muted, strings.ToLower(queue)) {
+			muted = append(muted, strings.ToLower(queue))
+		}
+	}
+
+	c.setMutedQueues(sub, muted)
+	c.Reply(ctx, fmt.Sprintf("Silenciadas para %s: %s", acc.RiotId(), strings.Join(muted, " ")))
+	return nil
+}
+
+func unmuteCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(muteArgs)
+
+	acc, ok := c.findAccount(ctx.Chat, args.Name)
+	if !ok {
+		return fmt.Errorf("no sigo a ninguna cuenta llamada %s", args.Name)
+	}
+
+	sub, _ := c.subscription(ctx.Chat, acc)
+
+	var muted []string
+	for _, queue := range strings.Fields(string(sub.MutedQueues)) {
+		if len(args.Queues) > 0 && !containsString(args.Queues, queue) {
+			muted = append(muted, queue)
+		}
+	}
+
+	c.setMutedQueues(sub, muted)
+	if len(muted) == 0 {
+		c.Reply(ctx, fmt.Sprintf("Se anuncian todas las partidas de %s", acc.RiotId()))
+	} else {
+		c.Reply(ctx, fmt.Sprintf("Siguen silenciadas para %s: %s", acc.RiotId(), strings.Join(muted, " ")))
+	}
+	return nil
+}
+
+func (c *LeviClient) setMutedQueues(sub Subscription, muted []string) {
+	c.db.Model(&sub).Update("muted_queues", QueueFilter(strings.Join(muted, " ")))
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func remakesCommand(c *LeviClient, ctx *CommandContext) error {
+	settings := c.groupSettings(ctx.Chat)
+
+	if len(ctx.Args) == 0 {
+		if settings.SkipRemakes {
+			c.Reply(ctx, "Los remakes no se anuncian")
+		} else {
+			c.Reply(ctx, "Los remakes se anuncian con un mensaje corto")
+		}
+		return nil
+	}
+
+	switch strings.ToLower(ctx.Args[0]) {
+	case "on", "mostrar":
+		settings.SkipRemakes = false
+	case "off", "ocultar":
+		settings.SkipRemakes = true
+	default:
+		return errUsage
+	}
+
+	c.db.Model(&settings).Update("skip_remakes", settings.SkipRemakes)
+	c.Reply(ctx, "Hecho")
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	registerCommand(&Command{
+		Name:        "rank",
+		Aliases:     []string{"elo"},
+		Usage:       ".rank [nombre#tag]",
+		Description: "Muestra el rango en Solo/Duo y Flex, sin nombre el de todos",
+		Role:        RoleMember,
+		Handler:     rankCommand,
+	})
+}
+
+func rankCommand(c *LeviClient, ctx *CommandContext) error {
+	var accs []Account
+
+	if len(ctx.Args) > 0 {
+		name := strings.Join(ctx.Args, " ")
+		acc, ok := c.findAccount(ctx.Chat, name)
+
+		if !ok {
+			return fmt.Errorf("no sigo a ninguna cuenta llamada %s", name)
+		}
+
+		accs = append(accs, acc)
+	} else {
+		accs = c.groupAccounts(ctx.Chat)
+	}
+
+	if len(accs) == 0 {
+		c.Reply(ctx, "No sigo a nadie, usa .addaccount <nombre#tag> [region]")
+		return nil
+	}
+
+	var blocks []string
+	for _, acc := range accs {
+		leagues, err := c.lolClient.GetLeagueBySummonerId(acc.Platform, acc.Id)
+
+		if err != nil {
+			// a single account failing shouldn't hide everyone else
+			if len(accs) == 1 {
+				return err
+			}
+			blocks = append(blocks, fmt.Sprintf("*%s*\n%s", acc.RiotId(), describeRiotError(err)))
+			continue
+		}
+
+		blocks = append(blocks, fmt.Sprintf("*%s*\n%s", acc.RiotId(), formatLeagues(leagues)))
+	}
+
+	c.Reply(ctx, strings.Join(blocks, "\n\n"))
+	return nil
+}
+
+// formatLeagues returns one line per ranked queue.
+func formatLeagues(leagues []League) string {
+	var lines []string
+
+	for _, queueType := range []string{soloQueueType, flexQueueType} {
+		if league, ok := findLeague(leagues, queueType); ok {
+			lines = append(lines, formatLeague(league))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s: sin rankear", queueTypeNames[queueType]))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
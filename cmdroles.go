@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+type grantArgs struct {
+	User string
+	Role Role
+}
+
+func init() {
+	registerCommand(&Command{
+		Name:        "grant",
+		Aliases:     []string{"dar"},
+		Usage:       ".grant <@usuario> <mod|admin>",
+		Description: "Da un rol en este grupo, solo se pueden dar roles por debajo del tuyo",
+		Role:        RoleAdmin,
+		Parse:       parseGrantArgs,
+		Handler:     grantCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "revoke",
+		Aliases:     []string{"quitar"},
+		Usage:       ".revoke <@usuario>",
+		Description: "Quita el rol de un usuario en este grupo",
+		Role:        RoleAdmin,
+		Parse:       requireArgs,
+		Handler:     revokeCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "roles",
+		Usage:       ".roles",
+		Description: "Lista los roles de este grupo",
+		Role:        RoleMember,
+		Handler:     rolesCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "wppadmins",
+		Usage:       ".wppadmins [on|off]",
+		Description: "Muestra o cambia si los admins del grupo de WhatsApp son admins del bot",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     wppAdminsCommand,
+	})
+}
+
+func parseGrantArgs(args []string) (interface{}, error) {
+	if len(args) != 2 {
+		return nil, errUsage
+	}
+
+	role, ok := parseRole(args[1])
+	if !ok || role == RoleMember {
+		return nil, fmt.Errorf("no existe el rol %s, usa mod o admin", args[1])
+	}
+
+	return grantArgs{args[0], role}, nil
+}
+
+// userFromArg returns the user of a mention like @34600111222, whatsapp
+// sends the mentioned JIDs apart from the text.
+func userFromArg(ctx *CommandContext, arg string) (string, bool) {
+	user := strings.TrimPrefix(arg, "@")
+
+	for _, mentioned := range ctx.Event.Message.GetExtendedTextMessage().GetContextInfo().GetMentionedJid() {
+		if jid, err := types.ParseJID(mentioned); err == nil && jid.User == user {
+			return jid.User, true
+		}
+	}
+
+	user = strings.TrimPrefix(user, "+")
+	if user == "" || strings.IndexFunc(user, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+		return "", false
+	}
+
+	return user, true
+}
+
+func grantCommand(c *LeviClient, ctx *CommandContext) error {
+	args := ctx.Parsed.(grantArgs)
+
+	user, ok := userFromArg(ctx, args.User)
+	if !ok {
+		return fmt.Errorf("%s no es un usuario, mencionale con @", args.User)
+	}
+
+	if args.Role >= ctx.Role {
+		return fmt.Errorf("solo puedes dar roles por debajo de %s", ctx.Role)
+	}
+
+	current := c.roleOf(ctx.Chat, types.NewJID(user, types.DefaultUserServer))
+	if current >= ctx.Role {
+		return fmt.Errorf("no puedes cambiar el rol de alguien con rol %s", current)
+	}
+
+	c.setRole(ctx.Chat, user, args.Role)
+	c.Reply(ctx, fmt.Sprintf("@%s ahora es %s", user, args.Role))
+	return nil
+}
+
+func revokeCommand(c *LeviClient, ctx *CommandContext) error {
+	user, ok := userFromArg(ctx, ctx.Args[0])
+	if !ok {
+		return fmt.Errorf("%s no es un usuario, mencionale con @", ctx.Args[0])
+	}
+
+	current := c.roleOf(ctx.Chat, types.NewJID(user, types.DefaultUserServer))
+	if current >= ctx.Role {
+		return fmt.Errorf("no puedes cambiar el rol de alguien con rol %s", current)
+	}
+
+	c.setRole(ctx.Chat, user, RoleMember)
+	c.Reply(ctx, fmt.Sprintf("@%s vuelve a ser %s", user, RoleMember))
+	return nil
+}
+
+func rolesCommand(c *LeviClient, ctx *CommandContext) error {
+	var members []MemberRole
+	c.db.Where(&MemberRole{GroupJID: ctx.Chat.String()}).Find(&members)
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].Role > members[j].Role
+	})
+
+	lines := []string{fmt.Sprintf("Roles (%s es el dueño del bot):", "@"+c.adminJID.User)}
+	for _, member := range members {
+		lines = append(lines, fmt.Sprintf("- @%s: %s", member.User, member.Role))
+	}
+
+	if c.groupSettings(ctx.Chat).WhatsappAdmins {
+		lines = append(lines, "Los admins del grupo de WhatsApp son admins del bot")
+	}
+
+	c.Reply(ctx, strings.Join(lines, "\n"))
+	return nil
+}
+
+func wppAdminsCommand(c *LeviClient, ctx *CommandContext) error {
+	settings := c.groupSettings(ctx.Chat)
+
+	if len(ctx.Args) == 0 {
+		if settings.WhatsappAdmins {
+			c.Reply(ctx, "Los admins del grupo de WhatsApp son admins del bot")
+		} else {
+			c.Reply(ctx, "Los admins del grupo de WhatsApp no tienen permisos especiales")
+		}
+		return nil
+	}
+
+	switch strings.ToLower(ctx.Args[0]) {
+	case "on":
+		settings.WhatsappAdmins = true
+	case "off":
+		settings.WhatsappAdmins = false
+	default:
+		return errUsage
+	}
+
+	c.db.Model(&settings).Update("whatsapp_admins", settings.WhatsappAdmins)
+	c.Reply(ctx, "Hecho")
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	registerCommand(&Command{
+		Name:        "rules",
+		Aliases:     []string{"reglas"},
+		Usage:       ".rules [id de partida]",
+		Description: "Lista las reglas de frases por prioridad, o cuales saltan en una partida",
+		Role:        RoleMember,
+		Handler:     rulesCommand,
+	})
+}
+
+var ruleResultNames = map[string]string{
+	"win":  "(solo victorias)",
+	"loss": "(solo derrotas)",
+}
+
+func rulesCommand(c *LeviClient, ctx *CommandContext) error {
+	rules, err := c.rules.Get()
+	if err != nil {
+		c.Reply(ctx, fmt.Sprintf("El fichero de reglas tiene errores, se usan las anteriores: %s", err))
+	}
+
+	if len(ctx.Args) == 0 {
+		lines := []string{"Reglas:"}
+		for _, rule := range rules {
+			line := fmt.Sprintf("%d. %s: %s → frases %s", rule.Priority, rule.Name, strings.Join(rule.When, ", "), rule.Category)
+			if result, ok := ruleResultNames[rule.Result]; ok {
+				line += " " + result
+			}
+			lines = append(lines, line)
+		}
+
+		c.Reply(ctx, strings.Join(lines, "\n"))
+		return nil
+	}
+
+	matchId := strings.ToUpper(ctx.Args[0])
+	match, err := c.lolClient.GetMatchById(matchId)
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+	for _, p := range match.Info.Participants {
+		var matched []string
+		for _, rule := range rules {
+			if rule.Matches(match, p) {
+				matched = append(matched, rule.Name)
+			}
+		}
+
+		if len(matched) > 0 {
+			lines = append(lines, fmt.Sprintf("%s (%s): %s", p.DisplayName(), p.ChampionName, strings.Join(matched, ", ")))
+		}
+	}
+
+	if len(lines) == 0 {
+		c.Reply(ctx, fmt.Sprintf("No salta ninguna regla en %s", matchId))
+		return nil
+	}
+
+	c.Reply(ctx, strings.Join(append([]string{matchId + ":"}, lines...), "\n"))
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	registerCommand(&Command{
+		Name:        "streak",
+		Aliases:     []string{"racha", "rachas"},
+		Usage:       ".streak",
+		Description: "Muestra la racha actual de cada cuenta en cada cola",
+		Role:        RoleMember,
+		Handler:     streakCommand,
+	})
+}
+
+func streakCommand(c *LeviClient, ctx *CommandContext) error {
+	accs := c.groupAccounts(ctx.Chat)
+
+	lines := []string{"Rachas actuales:"}
+	for _, acc := range accs {
+		var streaks []Streak
+		c.db.Where("puuid = ? AND count <> 0", acc.Puuid).Order("queue_id").Find(&streaks)
+
+		if len(streaks) == 0 {
+			continue
+		}
+
+		var parts []string
+		for _, streak := range streaks {
+			icon := "🔥"
+			if streak.Count < 0 {
+				icon = "💀"
+			}
+			parts = append(parts, fmt.Sprintf("%s %s %s", icon, queueName(streak.QueueId), formatStreak(streak.Count)))
+		}
+
+		lines = append(lines, fmt.Sprintf("*%s*: %s", acc.RiotId(), strings.Join(parts, " · ")))
+	}
+
+	if len(lines) == 1 {
+		c.Reply(ctx, "Nadie tiene rachas todavia")
+		return nil
+	}
+
+	c.Reply(ctx, strings.Join(lines, "\n"))
+	return nil
+}
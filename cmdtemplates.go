@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func init() {
+	registerCommand(&Command{
+		Name:        "template",
+		Aliases:     []string{"plantilla"},
+		Usage:       ".template [nombre|reload]",
+		Description: "Muestra o cambia la plantilla de los anuncios, reload vuelve a leer los ficheros",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     templateCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "charts",
+		Aliases:     []string{"graficas"},
+		Usage:       ".charts [on|off]",
+		Description: "Muestra o cambia si los anuncios llevan la grafica de oro",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     chartsCommand,
+	})
+
+	registerCommand(&Command{
+		Name:        "scorecards",
+		Aliases:     []string{"fichas"},
+		Usage:       ".scorecards [on|off]",
+		Description: "Muestra o cambia si los anuncios llevan una ficha con las stats de cada jugador",
+		Role:        RoleMember,
+		SetRole:     RoleAdmin,
+		Handler:     scorecardsCommand,
+	})
+}
+
+func templateCommand(c *LeviClient, ctx *CommandContext) error {
+	settings := c.groupSettings(ctx.Chat)
+
+	if len(ctx.Args) == 0 {
+		current := settings.Template
+		if current == "" {
+			current = defaultTemplate
+		}
+
+		c.Reply(ctx, fmt.Sprintf(
+			"Plantilla actual: %s\nDisponibles: %s",
+			current,
+			strings.Join(c.templates.Names(), ", "),
+		))
+		return nil
+	}
+
+	name := strings.ToLower(ctx.Args[0])
+
+	if name == "reload" {
+		if err := c.templates.Reload(); err != nil {
+			return err
+		}
+
+		c.Reply(ctx, "Plantillas recargadas")
+		return nil
+	}
+
+	if !c.templates.Exists(name) {
+		return fmt.Errorf("no existe la plantilla %s", name)
+	}
+
+	if _, err := c.templates.get(name); err != nil {
+		return err
+	}
+
+	c.db.Model(&settings).Update("template", name)
+	c.Reply(ctx, fmt.Sprintf("Ahora se usa la plantilla %s", name))
+	return nil
+}
+
+func chartsCommand(c *LeviClient, ctx *CommandContext) error {
+	settings := c.groupSettings(ctx.Chat)
+
+	if len(ctx.Args) == 0 {
+		if settings.SkipCharts {
+			c.Reply(ctx, "Los anuncios se mandan sin grafica")
+		} else {
+			c.Reply(ctx, "Los anuncios llevan la grafica de oro de la partida")
+		}
+		return nil
+	}
+
+	switch strings.ToLower(ctx.Args[0]) {
+	case "on", "mostrar":
+		settings.SkipCharts = false
+	case "off", "ocultar":
+		settings.SkipCharts = true
+	default:
+		return errUsage
+	}
+
+	c.db.Model(&settings).Update("skip_charts", settings.SkipCharts)
+	c.Reply(ctx, "Hecho")
+	return nil
+}
+
+func scorecardsCommand(c *LeviClient, ctx *CommandContext) error {
+	settings := c.groupSettings(ctx.Chat)
+
+	if len(ctx.Args) == 0 {
+		if settings.Scorecards {
+			c.Reply(ctx, "Los anuncios llevan una ficha por jugador")
+		} else {
+			c.Reply(ctx, "Los anuncios se mandan sin fichas")
+		}
+		return nil
+	}
+
+	switch strings.ToLower(ctx.Args[0]) {
+	case "on", "mostrar":
+		settings.Scorecards = true
+	case "off", "ocultar":
+		settings.Scorecards = false
+	default:
+		return errUsage
+	}
+
+	c.db.Model(&settings).Update("scorecards", settings.Scorecards)
+	c.Reply(ctx, "Hecho")
+	return nil
+}
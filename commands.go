@@ -0,0 +1,371 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+const commandPrefix = "."
+
+// Role is the permission level of a user in a group, and the minimum one
+// needed to run a command. The owner is the ADMIN of the bot, the rest are
+// granted per group with .grant.
+type Role int
+
+const (
+	RoleMember Role = iota
+	RoleModerator
+	RoleAdmin
+	RoleOwner
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleAdmin:
+		return "admin"
+	case RoleModerator:
+		return "moderator"
+	default:
+		return "member"
+	}
+}
+
+// CommandContext is what a handler gets for a single invocation.
+type CommandContext struct {
+	Event  *events.Message
+	Sender types.JID
+	Chat   types.JID
+	Role   Role
+	// Name the command was invoked with, it can be an alias
+	Name string
+	// Args are the raw arguments with quotes already removed
+	Args []string
+	// Parsed is whatever the command Parse function returned
+	Parsed interface{}
+}
+
+// Command is a bot command, each one registers itself from its own file
+// with registerCommand.
+type Command struct {
+	Name        string
+	Aliases     []string
+	Usage       string
+	Description string
+	Role        Role
+	// SetRole is the role needed to run it with arguments, for commands
+	// that show a setting without them and change it with them.
+	SetRole Role
+	// Parse validates the arguments and turns them into something the
+	// handler understands, commands without it get the raw arguments.
+	Parse   func(args []string) (interface{}, error)
+	Handler func(c *LeviClient, ctx *CommandContext) error
+}
+
+// errUsage makes the router answer with the usage of the command.
+var errUsage = errors.New("wrong arguments")
+
+var (
+	commands     = map[string]*Command{}
+	commandNames []string
+)
+
+func registerCommand(cmd *Command) {
+	for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+		if _, ok := commands[name]; ok {
+			panic(fmt.Sprintf("command %s registered twice", name))
+		}
+		commands[name] = cmd
+	}
+
+	commandNames = append(commandNames, cmd.Name)
+	sort.Strings(commandNames)
+}
+
+func init() {
+	registerCommand(&Command{
+		Name:        "help",
+		Aliases:     []string{"ayuda"},
+		Usage:       ".help [comando]",
+		Description: "Muestra los comandos disponibles",
+		Role:        RoleMember,
+		Handler:     helpCommand,
+	})
+}
+
+// suggestCommand returns the command or alias closest to name, when it is
+// close enough to be a typo: one letter off, or two for longer names.
+func suggestCommand(name string) (string, bool) {
+	best, bestDistance := "", -1
+
+	for _, candidate := range commandNames {
+		for _, alias := range append([]string{candidate}, commands[candidate].Aliases...) {
+			// three letter names are too close to normal words
+			allowed := 0
+			if len(alias) > 5 {
+				allowed = 2
+			} else if len(alias) > 3 {
+				allowed = 1
+			}
+
+			d := editDistance(name, alias)
+			if d <= allowed && (bestDistance < 0 || d < bestDistance) {
+				best, bestDistance = alias, d
+			}
+		}
+	}
+
+	return best, best != ""
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(minInt(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+		}
+		prev = cur
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// CommandHandler routes every message starting with the command prefix to
+// the registered command.
+func (c *LeviClient) CommandHandler(evt interface{}) {
+	v, ok := evt.(*events.Message)
+	if !ok {
+		return
+	}
+
+	name, args, err := parseCommand(messageText(v))
+
+	if name == "" {
+		return
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		// plenty of normal messages start with a dot, only typos of a real
+		// command get an answer
+		if suggestion, found := suggestCommand(name); found {
+			c.SendText(v.Info.Chat, fmt.Sprintf("No existe el comando %s%s, ¿querias decir %s%s?", commandPrefix, name, commandPrefix, suggestion))
+		}
+		return
+	}
+
+	if err != nil {
+		c.SendText(v.Info.Chat, fmt.Sprintf("No entiendo el comando: %s", err))
+		return
+	}
+
+	ctx := &CommandContext{
+		Event:  v,
+		Sender: v.Info.Sender,
+		Chat:   v.Info.Chat,
+		Role:   c.roleOf(v.Info.Chat, v.Info.Sender),
+		Name:   name,
+		Args:   args,
+		Parsed: args,
+	}
+
+	if ctx.Role < cmd.requiredRole(args) {
+		c.Reply(ctx, fmt.Sprintf("No tienes permisos para %s%s", commandPrefix, cmd.Name))
+		return
+	}
+
+	if cmd.Parse != nil {
+		parsed, err := cmd.Parse(args)
+
+		if err != nil {
+			c.replyUsage(ctx, cmd, err)
+			return
+		}
+
+		ctx.Parsed = parsed
+	}
+
+	if err := cmd.Handler(c, ctx); err != nil {
+		if errors.Is(err, errUsage) {
+			c.replyUsage(ctx, cmd, err)
+			return
+		}
+
+		c.wppClient.Log.Errorf("Command %s failed: %s", cmd.Name, err)
+		c.Reply(ctx, fmt.Sprintf("Error en %s%s: %s", commandPrefix, cmd.Name, describeRiotError(err)))
+	}
+}
+
+// requiredRole is the minimum role to run the command with args.
+func (cmd *Command) requiredRole(args []string) Role {
+	if len(args) > 0 && cmd.SetRole > cmd.Role {
+		return cmd.SetRole
+	}
+
+	return cmd.Role
+}
+
+func (c *LeviClient) replyUsage(ctx *CommandContext, cmd *Command, err error) {
+	if errors.Is(err, errUsage) {
+		c.Reply(ctx, fmt.Sprintf("Uso: %s", cmd.Usage))
+		return
+	}
+
+	c.Reply(ctx, fmt.Sprintf("%s\nUso: %s", err, cmd.Usage))
+}
+
+func (c *LeviClient) Reply(ctx *CommandContext, msg string) {
+	c.SendText(ctx.Chat, msg)
+}
+
+// messageText returns the text of plain messages and of messages with
+// mentions or quotes, which come as extended text.
+func messageText(v *events.Message) string {
+	if text := v.Message.GetConversation(); text != "" {
+		return text
+	}
+
+	return v.Message.GetExtendedTextMessage().GetText()
+}
+
+// parseCommand splits ".cmd arg "quoted arg"" into the lowercased command
+// name and its arguments. An empty name means the message isn't a command.
+func parseCommand(msg string) (string, []string, error) {
+	msg = strings.TrimSpace(msg)
+	if !strings.HasPrefix(msg, commandPrefix) {
+		return "", nil, nil
+	}
+
+	msg = strings.TrimPrefix(msg, commandPrefix)
+
+	// things like "..." or ". jaja" are not commands
+	if msg == "" || !unicode.IsLetter([]rune(msg)[0]) {
+		return "", nil, nil
+	}
+
+	params, err := splitArgs(msg)
+	if err != nil {
+		return strings.ToLower(strings.Fields(msg)[0]), nil, err
+	}
+
+	return strings.ToLower(params[0]), params[1:], nil
+}
+
+// splitArgs splits on whitespace keeping "double" or 'single' quoted text
+// together.
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+
+	for _, r := range s {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("falta cerrar las comillas %c", quote)
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// requireArgs is a Parse function for commands that need at least one
+// argument.
+func requireArgs(args []string) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, errUsage
+	}
+
+	return args, nil
+}
+
+func helpCommand(c *LeviClient, ctx *CommandContext) error {
+	if len(ctx.Args) > 0 {
+		name := strings.ToLower(strings.TrimPrefix(ctx.Args[0], commandPrefix))
+		cmd, ok := commands[name]
+
+		if !ok {
+			return fmt.Errorf("no existe el comando %s%s", commandPrefix, name)
+		}
+
+		help := fmt.Sprintf("%s\nUso: %s", cmd.Description, cmd.Usage)
+		if len(cmd.Aliases) > 0 {
+			help += fmt.Sprintf("\nAlias: %s", strings.Join(cmd.Aliases, ", "))
+		}
+		if cmd.Role > RoleMember {
+			help += fmt.Sprintf("\nNecesita rol: %s", cmd.Role)
+		}
+		if cmd.SetRole > cmd.Role {
+			help += fmt.Sprintf("\nPara cambiarlo necesita rol: %s", cmd.SetRole)
+		}
+
+		c.Reply(ctx, help)
+		return nil
+	}
+
+	lines := []string{"Comandos:"}
+	for _, name := range commandNames {
+		cmd := commands[name]
+		if ctx.Role < cmd.Role {
+			continue
+		}
+
+		line := fmt.Sprintf("%s - %s", cmd.Usage, cmd.Description)
+		if ctx.Role < cmd.SetRole {
+			line += fmt.Sprintf(" (cambiarlo: %s)", cmd.SetRole)
+		}
+		lines = append(lines, line)
+	}
+
+	c.Reply(ctx, strings.Join(lines, "\n"))
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+func TestSuggestCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		suggestion string
+	}{
+		{"acounts", "accounts"},
+		{"rnak", ""},
+		{"rnk", "rank"},
+		{"plantila", "plantilla"},
+		{"jajaja", ""},
+		{"eso", ""},
+		{"hola", ""},
+	}
+
+	for _, tt := range tests {
+		got, found := suggestCommand(tt.name)
+
+		if found != (tt.suggestion != "") || got != tt.suggestion {
+			t.Errorf("suggestCommand(%q) = %q, %v, want %q", tt.name, got, found, tt.suggestion)
+		}
+	}
+}
+
+func TestRequiredRole(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		role Role
+	}{
+		{"queues", nil, RoleMember},
+		{"queues", []string{"ranked"}, RoleAdmin},
+		{"remakes", []string{"off"}, RoleAdmin},
+		{"plantilla", nil, RoleMember},
+		{"template", []string{"reload"}, RoleAdmin},
+		{"charts", []string{"on"}, RoleAdmin},
+		{"fichas", nil, RoleMember},
+		{"wppadmins", []string{"on"}, RoleAdmin},
+		{"mute", []string{"keko", "aram"}, RoleModerator},
+		{"roles", nil, RoleMember},
+	}
+
+	for _, tt := range tests {
+		if got := commands[tt.name].requiredRole(tt.args); got != tt.role {
+			t.Errorf("%s %v needs %s, want %s", tt.name, tt.args, got, tt.role)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+const ddragonUrl = "https://ddragon.leagueoflegends.com"
+
+// How long the static data is kept before checking for a new patch, and
+// how long to wait after a failed download.
+const (
+	ddragonRefreshInterval = time.Hour * 24
+	ddragonRetryInterval   = time.Minute * 5
+)
+
+// DataDragon serves the static game data the Riot API only gives ids for,
+// like champion and summoner spell names, and the item and champion icons.
+// Every file is kept in a local bundle under dir with the layout of the
+// dragontail tarball Riot publishes, <version>/img/item/1001.png and so on,
+// so once a patch was downloaded it works without network, and an extracted
+// tarball works offline from the start.
+//
+// The names are only read from memory, Run refreshes them in the background
+// so a slow Data Dragon never blocks a command. mu only guards the fields,
+// downloads happen without it.
+type DataDragon struct {
+	httpClient *http.Client
+	log        waLog.Logger
+	url        string
+	dir        string
+	mu         sync.Mutex
+	version    string
+	champions  map[int]string
+	spells     map[int]string
+	icons      map[string]*ddragonIcon
+}
+
+// ddragonIcon is an icon loaded once, by the first caller that asks for it.
+type ddragonIcon struct {
+	once sync.Once
+	img  image.Image
+}
+
+// NewDataDragon caches the files in dir, nothing is written when it's empty.
+// Nothing is loaded until Run starts.
+func NewDataDragon(dir string, log waLog.Logger) *DataDragon {
+	return &DataDragon{
+		httpClient: &http.Client{Timeout: time.Second * 30},
+		log:        log,
+		url:        ddragonUrl,
+		dir:        dir,
+		icons:      map[string]*ddragonIcon{},
+	}
+}
+
+// Run loads the latest patch and checks for a new one every
+// ddragonRefreshInterval, or sooner while it can't be loaded. It never
+// returns.
+func (d *DataDragon) Run() {
+	for {
+		wait := ddragonRefreshInterval
+
+		if err := d.refresh(); err != nil {
+			d.log.Errorf("Could not refresh data dragon: %s", err)
+
+			d.mu.Lock()
+			if d.champions == nil {
+				wait = ddragonRetryInterval
+			}
+			d.mu.Unlock()
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+// ChampionName returns the name of a champion id, or the id itself when
+// Data Dragon wasn't loaded yet.
+func (d *DataDragon) ChampionName(id int) string {
+	return d.lookup(func() map[int]string { return d.champions }, id)
+}
+
+// SpellName returns the name of a summoner spell id, like Flash.
+func (d *DataDragon) SpellName(id int) string {
+	return d.lookup(func() map[int]string { return d.spells }, id)
+}
+
+func (d *DataDragon) lookup(names func() map[int]string, id int) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if name, ok := names()[id]; ok {
+		return name
+	}
+
+	return strconv.Itoa(id)
+}
+
+// ItemIcon returns the icon of an item, nil when it isn't in the bundle and
+// can't be downloaded. Icons missing from the bundle are downloaded by the
+// first caller, which only happens while rendering announcements.
+func (d *DataDragon) ItemIcon(id int) image.Image {
+	return d.icon(func(version string) string { return fmt.Sprintf("cdn/%s/img/item/%d.png", version, id) })
+}
+
+// ChampionIcon returns the square icon of a champion by the name match-v5
+// gives in championName, which is the Data Dragon id like "MonkeyKing".
+func (d *DataDragon) ChampionIcon(champion string) image.Image {
+	return d.icon(func(version string) string { return fmt.Sprintf("cdn/%s/img/champion/%s.png", version, champion) })
+}
+
+func (d *DataDragon) icon(path func(version string) string) image.Image {
+	d.mu.Lock()
+	if d.version == "" {
+		d.mu.Unlock()
+		return nil
+	}
+
+	file := path(d.version)
+	icon, ok := d.icons[file]
+	if !ok {
+		icon = &ddragonIcon{}
+		d.icons[file] = icon
+	}
+	d.mu.Unlock()
+
+	// misses are cached too, they are retried with the next patch
+	icon.once.Do(func() { icon.img = d.loadIcon(file) })
+	return icon.img
+}
+
+func (d *DataDragon) loadIcon(file string) image.Image {
+	body, err := d.fetch(file)
+
+	if err != nil {
+		d.log.Warnf("Could not get %s from data dragon: %s", file, err)
+		return nil
+	}
+
+	icon, err := png.Decode(bytes.NewReader(body))
+
+	if err != nil {
+		d.log.Warnf("Could not decode %s: %s", file, err)
+		return nil
+	}
+
+	return icon
+}
+
+// refresh loads the names of the latest patch, it's only called from Run.
+func (d *DataDragon) refresh() error {
+	d.mu.Lock()
+	current := d.version
+	d.mu.Unlock()
+
+	version, champions, spells, err := d.latest(current)
+	if err != nil || version == current {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.version, d.champions, d.spells = version, champions, spells
+	d.icons = map[string]*ddragonIcon{}
+	return nil
+}
+
+// latest returns the newest patch with its champion and summoner spell
+// names, without the names when it's still current.
+func (d *DataDragon) latest(current string) (string, map[int]string, map[int]string, error) {
+	var versions []string
+	if err := d.get("api/versions.json", &versions); err != nil {
+		// an extracted tarball has no versions.json, only its patch
+		if versions = d.bundledVersions(); len(versions) == 0 {
+			return "", nil, nil, err
+		}
+	}
+
+	if len(versions) == 0 {
+		return "", nil, nil, fmt.Errorf("data dragon: no versions")
+	}
+
+	if versions[0] == current {
+		return current, nil, nil, nil
+	}
+
+	champions, err := d.names(versions[0], "champion.json")
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	spells, err := d.names(versions[0], "summoner.json")
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return versions[0], champions, spells, nil
+}
+
+// names reads a data file keyed by the numeric id, which is the "key" field
+// in both champion.json and summoner.json.
+func (d *DataDragon) names(version, file string) (map[int]string, error) {
+	var data struct {
+		Data map[string]struct {
+			Key  string `json:"key"`
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+
+	if err := d.get(fmt.Sprintf("cdn/%s/data/en_US/%s", version, file), &data); err != nil {
+		return nil, err
+	}
+
+	names := map[int]string{}
+	for _, entry := range data.Data {
+		if id, err := strconv.Atoi(entry.Key); err == nil {
+			names[id] = entry.Name
+		}
+	}
+
+	return names, nil
+}
+
+func (d *DataDragon) get(path string, v interface{}) error {
+	body, err := d.fetch(path)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// fetch returns a file of the bundle, downloading it when it's missing.
+// Files of a patch never change so they are only downloaded once, the list
+// of versions is always asked for and the saved one is used when offline.
+func (d *DataDragon) fetch(path string) ([]byte, error) {
+	local := d.localPath(path)
+	immutable := path != "api/versions.json"
+
+	if d.dir != "" && immutable {
+		if body, err := ioutil.ReadFile(local); err == nil {
+			return body, nil
+		}
+	}
+
+	body, err := d.download(path)
+
+	if err != nil {
+		if d.dir != "" && !immutable {
+			if cached, cacheErr := ioutil.ReadFile(local); cacheErr == nil {
+				return cached, nil
+			}
+		}
+		return nil, err
+	}
+
+	if d.dir != "" {
+		if err := os.MkdirAll(filepath.Dir(local), 0755); err == nil {
+			err = ioutil.WriteFile(local, body, 0644)
+		}
+		if err != nil {
+			d.log.Warnf("Could not save %s: %s", local, err)
+		}
+	}
+
+	return body, nil
+}
+
+// localPath maps a CDN path to the bundle, "cdn/13.7.1/img/item/1001.png"
+// is "13.7.1/img/item/1001.png" and "api/versions.json" is "versions.json".
+func (d *DataDragon) localPath(path string) string {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "cdn/"), "api/")
+	return filepath.Join(d.dir, filepath.FromSlash(path))
+}
+
+// bundledVersions returns the patches found in the bundle, newest first.
+func (d *DataDragon) bundledVersions() []string {
+	if d.dir == "" {
+		return nil
+	}
+
+	entries, err := ioutil.ReadDir(d.dir)
+
+	if err != nil {
+		return nil
+	}
+
+	var versions [][]int
+	for _, entry := range entries {
+		if version, ok := parseDdragonVersion(entry.Name()); ok && entry.IsDir() {
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		for k := 0; k < len(versions[i]) && k < len(versions[j]); k++ {
+			if versions[i][k] != versions[j][k] {
+				return versions[i][k] > versions[j][k]
+			}
+		}
+		return len(versions[i]) > len(versions[j])
+	})
+
+	names := make([]string, 0, len(versions))
+	for _, version := range versions {
+		parts := make([]string, len(version))
+		for i, n := range version {
+			parts[i] = strconv.Itoa(n)
+		}
+		names = append(names, strings.Join(parts, "."))
+	}
+
+	return names
+}
+
+// parseDdragonVersion splits a patch like "13.7.1", the tarball also has
+// directories like "img" that aren't one.
+func parseDdragonVersion(name string) ([]int, bool) {
+	var version []int
+	for _, part := range strings.Split(name, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		version = append(version, n)
+	}
+
+	return version, len(version) > 1
+}
+
+func (d *DataDragon) download(path string) ([]byte, error) {
+	url := d.url + "/" + path
+	res, err := d.httpClient.Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("data dragon: %s answered %d", url, res.StatusCode)
+	}
+
+	return ioutil.ReadAll(res.Body)
+}
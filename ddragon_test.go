@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	waLog "go.mau.fi/whatsmeow/util/log"
+)
+
+// testDataDragon serves a copy of the bundle in testdata/ddragon, with a
+// fake Data Dragon behind it so nothing is downloaded for real.
+func testDataDragon(t *testing.T, handler http.HandlerFunc) *DataDragon {
+	t.Helper()
+
+	dir := t.TempDir()
+	err := filepath.Walk("testdata/ddragon", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		local := filepath.Join(dir, strings.TrimPrefix(path, filepath.Join("testdata", "ddragon")))
+		if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(local, body, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	ddragon := NewDataDragon(dir, waLog.Noop)
+	ddragon.url = server.URL
+	if err := ddragon.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	return ddragon
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	http.NotFound(w, r)
+}
+
+func TestDataDragonBundle(t *testing.T) {
+	ddragon := testDataDragon(t, notFound)
+
+	if got := ddragon.ChampionName(64); got != "Lee Sin" {
+		t.Errorf("ChampionName(64) = %q, want Lee Sin", got)
+	}
+	if got := ddragon.ChampionName(1); got != "1" {
+		t.Errorf("ChampionName of a missing champion = %q, want the id", got)
+	}
+	if got := ddragon.SpellName(4); got != "Flash" {
+		t.Errorf("SpellName(4) = %q, want Flash", got)
+	}
+	if ddragon.ChampionIcon("Yasuo") == nil {
+		t.Error("ChampionIcon(Yasuo) is nil")
+	}
+	if ddragon.ItemIcon(3031) == nil {
+		t.Error("ItemIcon(3031) is nil")
+	}
+	if ddragon.ItemIcon(3026) != nil {
+		t.Error("ItemIcon of a missing item isn't nil")
+	}
+}
+
+func TestDataDragonDownloadsIconsOnce(t *testing.T) {
+	icon := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	icon.Set(1, 1, color.White)
+	var body bytes.Buffer
+	png.Encode(&body, icon)
+
+	var requests int32
+	release := make(chan struct{})
+	ddragon := testDataDragon(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cdn/13.7.1/img/item/9999.png" {
+			http.NotFound(w, r)
+			return
+		}
+
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Write(body.Bytes())
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ddragon.ItemIcon(9999) == nil {
+				t.Error("ItemIcon(9999) is nil")
+			}
+		}()
+	}
+
+	// the rest of the data is still served while the icon downloads
+	done := make(chan string)
+	go func() { done <- ddragon.ChampionName(157) }()
+
+	select {
+	case name := <-done:
+		if name != "Yasuo" {
+			t.Errorf("ChampionName(157) = %q, want Yasuo", name)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("ChampionName waited for the icon download")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Errorf("the icon was downloaded %d times, want 1", requests)
+	}
+}
+
+func TestDataDragonLookupDoesNotDownload(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	ddragon := NewDataDragon(t.TempDir(), waLog.Noop)
+	ddragon.url = server.URL
+
+	// nothing is loaded until Run, lookups answer with what there is
+	if got := ddragon.ChampionName(157); got != "157" {
+		t.Errorf("ChampionName before loading = %q, want the id", got)
+	}
+	if ddragon.ItemIcon(3031) != nil {
+		t.Error("ItemIcon before loading isn't nil")
+	}
+	if requests != 0 {
+		t.Errorf("lookups made %d requests", requests)
+	}
+}
@@ -2,19 +2,23 @@ module github.com/keko950/botlevi
 
 go 1.17
 
+require (
+	github.com/joho/godotenv v1.5.1
+	github.com/mattn/go-sqlite3 v1.14.16
+	github.com/mdp/qrterminal v1.0.1
+	go.mau.fi/whatsmeow v0.0.0-20230410091758-46e30e265256
+	golang.org/x/image v0.10.0
+	google.golang.org/protobuf v1.28.1
+	gorm.io/driver/sqlite v1.5.0
+	gorm.io/gorm v1.25.0
+)
+
 require (
 	filippo.io/edwards25519 v1.0.0 // indirect
 	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
-	github.com/joho/godotenv v1.5.1 // indirect
-	github.com/mattn/go-sqlite3 v1.14.16 // indirect
-	github.com/mdp/qrterminal v1.0.1 // indirect
 	go.mau.fi/libsignal v0.1.0 // indirect
-	go.mau.fi/whatsmeow v0.0.0-20230410091758-46e30e265256 // indirect
 	golang.org/x/crypto v0.5.0 // indirect
-	google.golang.org/protobuf v1.28.1 // indirect
-	gorm.io/driver/sqlite v1.5.0 // indirect
-	gorm.io/gorm v1.25.0 // indirect
 	rsc.io/qr v0.2.0 // indirect
 )
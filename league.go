@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	soloQueueType = "RANKED_SOLO_5x5"
+	flexQueueType = "RANKED_FLEX_SR"
+)
+
+// findLeague returns the entry of the given queue type.
+func findLeague(leagues []League, queueType string) (League, bool) {
+	for _, league := range leagues {
+		if league.QueueType == queueType {
+			return league, true
+		}
+	}
+
+	return League{}, false
+}
+
+// formatRank returns things like "GOLD II 45 LP", apex tiers have no
+// division.
+func formatRank(league League) string {
+	switch league.Tier {
+	case "MASTER", "GRANDMASTER", "CHALLENGER":
+		return fmt.Sprintf("%s %d LP", league.Tier, league.LeaguePoints)
+	default:
+		return fmt.Sprintf("%s %s %d LP", strings.ToUpper(league.Tier), league.Rank, league.LeaguePoints)
+	}
+}
+
+// queueTypeNames are the names shown for the ranked queues.
+var queueTypeNames = map[string]string{
+	soloQueueType: "Solo/Duo",
+	flexQueueType: "Flex",
+}
+
+// formatLeague returns the full standing of a league entry, with the record,
+// winrate, hot streak and promo series when there is one.
+func formatLeague(league League) string {
+	games := league.Wins + league.Losses
+	winrate := 0
+	if games > 0 {
+		winrate = league.Wins * 100 / games
+	}
+
+	line := fmt.Sprintf(
+		"%s: %s · %dV/%dD (%d%%)",
+		queueTypeNames[league.QueueType],
+		formatRank(league),
+		league.Wins,
+		league.Losses,
+		winrate,
+	)
+
+	if league.HotStreak {
+		line += " · en racha 🔥"
+	}
+
+	if league.MiniSeries.Progress != "" {
+		line += " · promo " + formatMiniSeries(league.MiniSeries.Progress)
+	}
+
+	return line
+}
+
+// formatMiniSeries turns Riot's "WLN" progress into "✅❌➖".
+func formatMiniSeries(progress string) string {
+	return strings.NewReplacer("W", "✅", "L", "❌", "N", "➖").Replace(progress)
+}
+
+// rankedQueueTypes maps match-v5 queue ids to league-v4 queue types.
+var rankedQueueTypes = map[int]string{
+	420: soloQueueType,
+	440: flexQueueType,
+}
+
+var tierOrder = []string{
+	"IRON", "BRONZE", "SILVER", "GOLD", "PLATINUM", "EMERALD", "DIAMOND",
+	"MASTER", "GRANDMASTER", "CHALLENGER",
+}
+
+var divisionOrder = []string{"IV", "III", "II", "I"}
+
+func tierIndex(tier string) int {
+	for i, t := range tierOrder {
+		if t == tier {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func divisionIndex(division string) int {
+	for i, d := range divisionOrder {
+		if d == division {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// absoluteLP places a rank in a single LP scale so deltas work across
+// divisions and tiers. Apex tiers share one ladder starting at master.
+func absoluteLP(tier, division string, lp int) int {
+	i := tierIndex(tier)
+	master := tierIndex("MASTER")
+
+	if i >= master {
+		return master*400 + lp
+	}
+
+	return i*400 + divisionIndex(division)*100 + lp
+}
+
+// compareRanks returns >0 when a is a higher tier or division than b.
+func compareRanks(aTier, aDivision, bTier, bDivision string) int {
+	if aTier != bTier {
+		return tierIndex(aTier) - tierIndex(bTier)
+	}
+
+	if tierIndex(aTier) >= tierIndex("MASTER") {
+		return 0
+	}
+
+	return divisionIndex(aDivision) - divisionIndex(bDivision)
+}
@@ -1,16 +1,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"image/png"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"math/rand"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types"
-	"go.mau.fi/whatsmeow/types/events"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -18,35 +22,76 @@ import (
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 )
 
-var lossPhrases = []string{
-	"es mas malo que la infancia en siria!",
-	"es peor que la morgana de biche!",
-	"juega peor que el depor!",
-	"esta una partida mas cerca del descenso, como el atleti!",
-	"se merece un relojazo!",
-	"deberia haber estudiao!",
-	"tiene menos elo que pelo gibe!",
-	"tira palla bobo!",
-}
+// Amount of recent match ids checked on every poll, and how many of the new
+// ones get announced before the rest are only summarized.
+const (
+	matchWindowSize     = 20
+	maxAnnouncedMatches = 5
+)
 
-var winPhrases = []string{
-	"ha chupado otro carrito!",
-	"ha ganado de puto milagro!",
-	"ha sobornado a negreira!",
-	"parece VINI JR!",
-	"va de relojazo en relojazo!",
-	"se ha ganado unos kekos!",
-	"ES IMPARABLE!",
-	"ha despertado a pos!",
-}
+// How often the riot ids of the tracked accounts are checked for renames.
+const riotIdRefreshInterval = time.Hour * 6
 
 type LeviClient struct {
 	wppClient   *whatsmeow.Client
 	lolClient   *LolClient
+	ddragon     *DataDragon
 	db          *gorm.DB
-	playerCache map[string]map[string]string
-	groupJID    types.JID
-	adminJID    types.JID
+	playerCache map[string]*trackedPlayer
+	cacheMu     sync.Mutex
+	// groupJID is the GROUP of the single group versions, it gets subscribed
+	// to every account the first time the bot runs with subscriptions
+	groupJID types.JID
+	adminJID types.JID
+	// errors already reported to the groups, cleared once polling works again
+	reportedErrors map[string]bool
+	templates      *Templates
+	rules          *Rules
+	// ids of the last phrases picked per category, to avoid repeating them
+	recentPhrases map[string][]uint
+	phrasesMu     sync.Mutex
+	// ranked games whose LP league-v4 didn't count yet, by puuid
+	pendingLeagues map[string]*pendingLeague
+	// players in a game, by puuid, so spectator isn't asked again until the
+	// match shows up
+	liveMatches map[string]liveMatch
+	// WhatsApp admins per group, fetched again after groupAdminsTTL
+	groupAdmins   map[string]groupAdmins
+	groupAdminsMu sync.Mutex
+}
+
+// trackedPlayer is a playerCache entry, the state is saved after every
+// announced match. Entries are only read and changed with cacheMu held, the
+// rest of the bot works on copies from trackedPlayers.
+type trackedPlayer struct {
+	account Account
+	state   TrackingState
+}
+
+// openDatabase opens the sqlite database and migrates every table.
+func openDatabase(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.AutoMigrate(
+		&Account{},
+		&TrackingState{},
+		&MatchRecord{},
+		&ParticipantRecord{},
+		&LeagueSnapshot{},
+		&GroupSettings{},
+		&Streak{},
+		&Phrase{},
+		&SeededCategory{},
+		&Subscription{},
+		&MemberRole{},
+		&LiveGame{},
+	)
+
+	return db, err
 }
 
 func NewLeviClient(
@@ -54,84 +99,359 @@ func NewLeviClient(
 	lolClient *LolClient,
 	groupJID string,
 	adminJID string,
+	templatesDir string,
+	rulesPath string,
+	ddragonDir string,
 ) *LeviClient {
 	var accs []Account
-	cache := map[string]map[string]string{}
+	cache := map[string]*trackedPlayer{}
 
-	db, err := gorm.Open(sqlite.Open("botlevi.sqlite"), &gorm.Config{})
+	db, err := openDatabase("botlevi.sqlite")
 
 	if err != nil {
 		panic(err)
 	}
 
-	db.AutoMigrate(&Account{})
-
 	db.Find(&accs)
 	for _, acc := range accs {
-		matchId, _ := lolClient.GetLastMatchId(acc.Puuid)
-		cache[acc.Puuid] = map[string]string{"lastMatchId": matchId}
+		var state TrackingState
+
+		// resume from the last announced match, the first poll catches up
+		// with whatever was played while the bot was down
+		if db.Where("puuid = ?", acc.Puuid).Limit(1).Find(&state).RowsAffected == 0 {
+			matchId, err := lolClient.GetLastMatchId(acc.Platform, acc.Puuid)
+			if err != nil && !errors.Is(err, ErrNoMatches) {
+				client.Log.Errorf("Could not retrieve last match of %s: %s", acc.Name, err)
+			}
+			state = newTrackingState(acc, matchId)
+			db.Create(&state)
+		}
+
+		cache[acc.Puuid] = &trackedPlayer{acc, state}
 	}
 
 	chat, _ := types.ParseJID(groupJID)
 	admin, _ := types.ParseJID(adminJID)
 
-	return &LeviClient{client, lolClient, db, cache, chat, admin}
+	leviClient := &LeviClient{
+		wppClient:      client,
+		lolClient:      lolClient,
+		ddragon:        NewDataDragon(ddragonDir, client.Log.Sub("DataDragon")),
+		db:             db,
+		playerCache:    cache,
+		groupJID:       chat,
+		adminJID:       admin,
+		reportedErrors: map[string]bool{},
+		templates:      NewTemplates(templatesDir),
+		rules:          NewRules(rulesPath),
+		recentPhrases:  map[string][]uint{},
+		groupAdmins:    map[string]groupAdmins{},
+		liveMatches:    map[string]liveMatch{},
+		pendingLeagues: map[string]*pendingLeague{},
+	}
+
+	leviClient.seedPhrases()
+	leviClient.migrateSubscriptions(chat)
+
+	return leviClient
+}
+
+// newTrackingState starts tracking from matchId, when it is unknown only
+// matches started from now on are announced.
+func newTrackingState(acc Account, matchId string) TrackingState {
+	state := TrackingState{Puuid: acc.Puuid, LastMatchId: matchId}
+
+	if matchId == "" {
+		state.LastMatchTime = time.Now().Unix()
+	}
+
+	return state
+}
+
+// trackedPlayers returns a copy of every playerCache entry so they can be
+// used while commands add, rename or remove accounts.
+func (c *LeviClient) trackedPlayers() []*trackedPlayer {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	players := make([]*trackedPlayer, 0, len(c.playerCache))
+	for _, p := range c.playerCache {
+		player := *p
+		players = append(players, &player)
+	}
+
+	return players
+}
+
+// updateState applies update to the copy of a player and to its cached
+// entry, and saves it. Players removed meanwhile are left alone so their
+// state isn't saved back.
+func (c *LeviClient) updateState(player *trackedPlayer, update func(state *TrackingState)) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	update(&player.state)
+
+	cached, ok := c.playerCache[player.account.Puuid]
+	if !ok {
+		return
+	}
+
+	update(&cached.state)
+	c.db.Save(&cached.state)
+}
+
+// untrackAccount stops polling an account and deletes its state.
+func (c *LeviClient) untrackAccount(acc Account) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	// dropped from the cache first so an update in flight doesn't save the
+	// state again
+	delete(c.playerCache, acc.Puuid)
+	c.db.Unscoped().Where("puuid = ?", acc.Puuid).Delete(&TrackingState{})
 }
 
 func (c *LeviClient) CheckForNewMatches() {
+	go c.ddragon.Run()
 	rand.Seed(time.Now().UnixNano())
+	c.refreshRiotIds()
+	c.snapshotMissingLeagues()
+	lastRefresh := time.Now()
+	lastLiveCheck := time.Time{}
+
 	for range time.Tick(time.Second * 30) {
-		for puuid, value := range c.playerCache {
-			matchId, _ := c.lolClient.GetLastMatchId(puuid)
-			if matchId != value["lastMatchId"] {
-				value["lastMatchId"] = matchId
-				match, err := c.lolClient.GetMatchById(matchId)
-
-				if err != nil {
-					continue
+		if time.Since(lastRefresh) > riotIdRefreshInterval {
+			c.refreshRiotIds()
+			lastRefresh = time.Now()
+		}
+
+		c.retryPendingLeagues()
+
+		if c.pollMatches() {
+			c.reportedErrors = map[string]bool{}
+		}
+
+		if time.Since(lastLiveCheck) > liveCheckInterval {
+			c.checkLiveGames()
+			lastLiveCheck = time.Now()
+		}
+	}
+}
+
+// pollMatches announces, oldest first, every match the tracked players
+// finished since their last announced one. Matches shared by several players
+// are fetched and announced once. Returns false when a request failed, the
+// remaining matches are picked up again on the next poll.
+func (c *LeviClient) pollMatches() bool {
+	ok := true
+	newIds := map[*trackedPlayer][]string{}
+
+	for _, player := range c.trackedPlayers() {
+		ids, err := c.newPlayerMatchIds(player)
+
+		if err != nil {
+			c.reportError("Error buscando partidas", err)
+			ok = false
+			continue
+		}
+
+		newIds[player] = ids
+	}
+
+	// fetch every match once, a nil entry means it failed and is retried
+	// unless Riot doesn't have it at all
+	matches := map[string]*Match{}
+	missing := map[string]bool{}
+	for _, ids := range newIds {
+		for _, id := range ids {
+			if _, fetched := matches[id]; fetched {
+				continue
+			}
+
+			match, err := c.lolClient.GetMatchById(id)
+
+			if err != nil {
+				// a missing match will never show up, anything else is retried
+				if errors.Is(err, ErrNotFound) {
+					missing[id] = true
+				} else {
+					c.reportError("Error descargando la partida "+id, err)
+					ok = false
 				}
+				matches[id] = nil
+				continue
+			}
+
+			matches[id] = &match
+		}
+	}
 
-				for _, v := range match.Info.Participants {
-					if v.Puuid == puuid {
-						if v.Win {
-							c.wppClient.Log.Infof("DR EARLY HA GANADO")
-							c.SendMessage(
-								fmt.Sprintf(
-									"Bot: Ring Ring, VICTORIA! %s %s \n CAMPEON: %s \n DURACION: %d minutos \n STATS: %d/%d/%d \n DAÑO REALIZADO: %d \n HA PINGEADO UN TOTAL DE: %d \n ",
-									v.SummonerName,
-									winPhrases[rand.Intn(len(winPhrases)-0+1)+0],
-									v.ChampionName,
-									v.TimePlayed/60,
-									v.Kills,
-									v.Deaths,
-									v.Assists,
-									v.TotalDamageDealtToChampions,
-									(v.HoldPings + v.PushPings + v.CommandPings + v.BasicPings + v.AssistMePings + v.OnMyWayPings + v.BaitPings + v.GetBackPings),
-								),
-							)
-						} else {
-							c.wppClient.Log.Infof("DR EARLY HA PERDIDO")
-							c.SendMessage(
-								fmt.Sprintf("Bot: Ring Ring, DERROTA! %s %s \n CAMPEON: %s \n DURACION: %d minutos \n STATS: %d/%d/%d \n DAÑO REALIZADO: %d\n HA PINGEADO UN TOTAL DE: %d \n ",
-									v.SummonerName,
-									lossPhrases[rand.Intn(len(lossPhrases)-0+1)+0],
-									v.ChampionName,
-									v.TimePlayed/60,
-									v.Kills,
-									v.Deaths,
-									v.Assists,
-									v.TotalDamageDealtToChampions,
-									(v.HoldPings + v.PushPings + v.CommandPings + v.BasicPings + v.AssistMePings + v.OnMyWayPings + v.BaitPings + v.GetBackPings),
-								),
-							)
-						}
-
-					}
+	// group players by match, a player stops at its first failed match so
+	// its state never skips over it
+	players := map[string][]*trackedPlayer{}
+	for player, ids := range newIds {
+		for _, id := range ids {
+			if matches[id] == nil {
+				if !missing[id] {
+					break
 				}
+				c.updateState(player, func(state *TrackingState) {
+					state.LastMatchId = id
+				})
+				continue
+			}
+			players[id] = append(players[id], player)
+		}
+	}
+
+	var ordered []*Match
+	for id := range players {
+		ordered = append(ordered, matches[id])
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Info.GameEndTimestamp < ordered[j].Info.GameEndTimestamp
+	})
+
+	for _, match := range ordered {
+		c.processMatch(*match, players[match.Metadata.MatchID])
+	}
+
+	return ok
+}
+
+// newPlayerMatchIds returns the ids of the matches the player finished since
+// the last announced one, oldest first. When there are too many only the
+// last ones are returned and the rest are summarized.
+func (c *LeviClient) newPlayerMatchIds(player *trackedPlayer) ([]string, error) {
+	acc := player.account
+	ids, err := c.lolClient.GetMatchIds(acc.Platform, acc.Puuid, player.state.LastMatchTime, matchWindowSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	newIds := newMatchIds(ids, player.state.LastMatchId)
+
+	if len(newIds) > maxAnnouncedMatches {
+		skipped := newIds[:len(newIds)-maxAnnouncedMatches]
+		newIds = newIds[len(newIds)-maxAnnouncedMatches:]
+		c.updateState(player, func(state *TrackingState) {
+			state.LastMatchId = skipped[len(skipped)-1]
+		})
+
+		c.SendToGroups(c.followers(acc), fmt.Sprintf(
+			"Bot: %s ha jugado %d partidas mientras no miraba, solo anuncio las %d ultimas",
+			acc.RiotId(),
+			len(skipped)+len(newIds),
+			len(newIds),
+		))
+	}
+
+	return newIds, nil
+}
+
+// newMatchIds returns the ids newer than lastMatchId in chronological order,
+// ids come from Riot newest first.
+func newMatchIds(ids []string, lastMatchId string) []string {
+	var newIds []string
+
+	for _, id := range ids {
+		if id == lastMatchId {
+			break
+		}
+		newIds = append([]string{id}, newIds...)
+	}
+
+	return newIds
+}
+
+// processMatch saves the state of every tracked player in the match and
+// sends a single announcement to each group following any of them.
+func (c *LeviClient) processMatch(match Match, players []*trackedPlayer) {
+	leagueChanges := map[string]*LeagueChange{}
+	streakChanges := map[string]*StreakChange{}
+	byPuuid := map[string]*trackedPlayer{}
+
+	for _, player := range players {
+		c.updateState(player, func(state *TrackingState) {
+			state.LastMatchId = match.Metadata.MatchID
+			state.LastMatchTime = match.Info.GameEndTimestamp / 1000
+		})
+		byPuuid[player.account.Puuid] = player
+		delete(c.liveMatches, player.account.Puuid)
+
+		// LP is tracked even for muted queues so the next delta is right
+		if change := c.trackLeagueChange(player.account, match); change != nil {
+			leagueChanges[player.account.Puuid] = change
+		}
+
+		if change := c.updateStreak(player.account, match); change != nil {
+			streakChanges[player.account.Puuid] = change
+		}
+	}
+
+	c.storeMatch(match, c.trackedPuuidsIn(match))
+
+	groups, subs := c.matchSubscriptions(players)
+	var timeline *MatchTimeline
+	for _, jid := range groups {
+		group, err := types.ParseJID(jid)
+		if err != nil {
+			continue
+		}
+
+		var announced []*trackedPlayer
+		for _, sub := range subs[jid] {
+			if c.shouldAnnounce(group, sub, match.Info.QueueID) {
+				announced = append(announced, byPuuid[sub.Puuid])
+			}
+		}
+
+		if len(announced) == 0 {
+			continue
+		}
+
+		// fetched once, and only when some group hears about the match
+		if timeline == nil {
+			timeline = c.matchTimeline(match)
+		}
+
+		c.announce(group, match, timeline, announced, leagueChanges)
+
+		for _, player := range announced {
+			if change, ok := leagueChanges[player.account.Puuid]; ok {
+				c.announceLeagueChange(group, player.account, change)
+			}
+
+			if change, ok := streakChanges[player.account.Puuid]; ok {
+				c.announceStreakChange(group, player.account, change)
 			}
 		}
+	}
+}
+
+// reportError logs err and tells the groups about it, each kind of error is
+// only sent once until a polling round finishes without failures.
+func (c *LeviClient) reportError(prefix string, err error) {
+	c.wppClient.Log.Errorf("%s: %s", prefix, err)
 
+	var riotErr *RiotError
+	if !errors.As(err, &riotErr) {
+		return
 	}
+
+	// rate limits are already handled by the limiter, no need to bother anyone
+	if riotErr.Kind == ErrRateLimited {
+		return
+	}
+
+	if c.reportedErrors[riotErr.Kind.Error()] {
+		return
+	}
+
+	c.reportedErrors[riotErr.Kind.Error()] = true
+	c.SendToGroups(c.subscribedGroups(), fmt.Sprintf("Bot: %s: %s", prefix, describeRiotError(err)))
 }
 
 func (c *LeviClient) AddEventHandlers() {
@@ -139,65 +459,162 @@ func (c *LeviClient) AddEventHandlers() {
 
 }
 
-func (c *LeviClient) SendMessage(msg string) {
-	c.wppClient.SendMessage(
-		context.Background(),
-		c.groupJID,
-		&waProto.Message{Conversation: proto.String(msg)},
-	)
+func (c *LeviClient) SendToGroups(groups []types.JID, msg string) {
+	for _, group := range groups {
+		c.SendText(group, msg)
+	}
+}
+
+// SendText sends a plain message and returns its id.
+func (c *LeviClient) SendText(chat types.JID, msg string) types.MessageID {
+	return c.send(chat, &waProto.Message{Conversation: proto.String(msg)})
+}
+
+// SendQuote sends a message replying to an earlier one sent by the bot.
+func (c *LeviClient) SendQuote(chat types.JID, msg string, quotedId types.MessageID, quotedText string) types.MessageID {
+	return c.send(chat, &waProto.Message{
+		ExtendedTextMessage: &waProto.ExtendedTextMessage{
+			Text:        proto.String(msg),
+			ContextInfo: c.quoteContext(quotedId, quotedText),
+		},
+	})
 }
 
-func (c *LeviClient) retrievePlayerInfo(summonerName string) (Account, error) {
-	summoner, err := c.lolClient.GetSummonerByName(summonerName)
+// SendImage uploads a PNG and sends it with the caption, replying to an
+// earlier message of the bot when quotedId isn't empty.
+func (c *LeviClient) SendImage(chat types.JID, data []byte, caption string, quotedId types.MessageID, quotedText string) types.MessageID {
+	config, err := png.DecodeConfig(bytes.NewReader(data))
 
 	if err != nil {
-		return Account{}, nil
+		c.wppClient.Log.Errorf("Could not read image for %s: %s", chat, err)
+		return ""
+	}
+
+	uploaded, err := c.wppClient.Upload(context.Background(), data, whatsmeow.MediaImage)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not upload image for %s: %s", chat, err)
+		return ""
+	}
+
+	msg := &waProto.ImageMessage{
+		Url:           proto.String(uploaded.URL),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSha256: uploaded.FileEncSHA256,
+		FileSha256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uploaded.FileLength),
+		Mimetype:      proto.String("image/png"),
+		Caption:       proto.String(caption),
+		Width:         proto.Uint32(uint32(config.Width)),
+		Height:        proto.Uint32(uint32(config.Height)),
+	}
+
+	if quotedId != "" {
+		msg.ContextInfo = c.quoteContext(quotedId, quotedText)
+	}
+
+	return c.send(chat, &waProto.Message{ImageMessage: msg})
+}
+
+func (c *LeviClient) quoteContext(quotedId types.MessageID, quotedText string) *waProto.ContextInfo {
+	return &waProto.ContextInfo{
+		StanzaId:      proto.String(quotedId),
+		Participant:   proto.String(c.wppClient.Store.ID.ToNonAD().String()),
+		QuotedMessage: &waProto.Message{Conversation: proto.String(quotedText)},
+	}
+}
+
+func (c *LeviClient) send(chat types.JID, msg *waProto.Message) types.MessageID {
+	res, err := c.wppClient.SendMessage(context.Background(), chat, msg)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not send message to %s: %s", chat, err)
+		return ""
+	}
+
+	return res.ID
+}
+
+// retrievePlayerInfo looks up a player by Riot ID (name#tag), or by the old
+// summoner name when no tag is given.
+func (c *LeviClient) retrievePlayerInfo(platform, riotId string) (Account, error) {
+	var summoner Summoner
+	var riotAccount RiotAccount
+	var err error
+
+	if i := strings.LastIndex(riotId, "#"); i >= 0 {
+		riotAccount, err = c.lolClient.GetAccountByRiotId(platform, riotId[:i], riotId[i+1:])
+
+		if err != nil {
+			return Account{}, err
+		}
+
+		summoner, err = c.lolClient.GetSummonerByPuuid(platform, riotAccount.Puuid)
+	} else {
+		summoner, err = c.lolClient.GetSummonerByName(platform, strings.ReplaceAll(riotId, " ", ""))
+
+		if err == nil {
+			// the name is enough to track it, the riot id is just nice to have
+			riotAccount, _ = c.lolClient.GetAccountByPuuid(platform, summoner.Puuid)
+		}
+	}
+
+	if err != nil {
+		return Account{}, err
+	}
+
+	name := summoner.Name
+	if riotAccount.GameName != "" {
+		name = riotAccount.GameName + "#" + riotAccount.TagLine
 	}
 
 	return Account{
-		Name:      summoner.Name,
+		Name:      name,
 		Accountid: summoner.AccountID,
 		Id:        summoner.ID,
 		Puuid:     summoner.Puuid,
+		Platform:  platform,
+		GameName:  riotAccount.GameName,
+		TagLine:   riotAccount.TagLine,
 	}, nil
 }
 
-func (c *LeviClient) CommandHandler(evt interface{}) {
-	switch v := evt.(type) {
-	case *events.Message:
-		msg := strings.ToLower(v.Message.GetConversation())
-		if strings.HasPrefix(msg, ".") {
-			// param 0 should be the command
-			// param 1 should be the value
-			params := strings.Split(msg, " ")
-			if len(params) == 0 {
-				return
-			}
+// refreshRiotIds updates the stored gameName#tagLine of every account so
+// renamed players keep showing up with their current name.
+func (c *LeviClient) refreshRiotIds() {
+	var accs []Account
+	c.db.Find(&accs)
 
-			switch params[0] {
-			case ".addaccount":
-				if v.Info.Sender.String() != c.adminJID.String() {
-					return
-				}
+	for _, acc := range accs {
+		riotAccount, err := c.lolClient.GetAccountByPuuid(acc.Platform, acc.Puuid)
 
-				acc, err := c.retrievePlayerInfo(strings.Join(params[1:], ""))
+		if err != nil {
+			c.wppClient.Log.Errorf("Could not refresh riot id of %s: %s", acc.RiotId(), err)
+			continue
+		}
 
-				if err != nil {
-					c.wppClient.Log.Errorf("%s", err)
-					return
-				}
+		if riotAccount.GameName == acc.GameName && riotAccount.TagLine == acc.TagLine {
+			continue
+		}
 
-				c.db.Create(&acc)
-				c.wppClient.Log.Infof("Added account: %+v\n", acc)
-				matchId, _ := c.lolClient.GetLastMatchId(acc.Puuid)
-				c.playerCache[acc.Puuid] = map[string]string{"lastMatchId": matchId}
-				var accs []string
-				for k := range c.playerCache {
-					accs = append(accs, k)
-				}
-				c.SendMessage(fmt.Sprintf("Tracking new account.. current accounts: %s", strings.Join(accs, "")))
-			}
+		renamed := acc.GameName != ""
+		oldName := acc.RiotId()
+		acc.GameName = riotAccount.GameName
+		acc.TagLine = riotAccount.TagLine
+		c.db.Model(&acc).Updates(map[string]interface{}{
+			"game_name": acc.GameName,
+			"tag_line":  acc.TagLine,
+		})
+
+		c.cacheMu.Lock()
+		if player, ok := c.playerCache[acc.Puuid]; ok {
+			player.account = acc
 		}
+		c.cacheMu.Unlock()
 
+		if renamed {
+			c.SendToGroups(c.followers(acc), fmt.Sprintf("Bot: %s ahora se llama %s", oldName, acc.RiotId()))
+		}
 	}
 }
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// newTestClient returns a client with an empty database and no WhatsApp or
+// Riot connection.
+func newTestClient(t *testing.T) *LeviClient {
+	t.Helper()
+
+	db, err := openDatabase(filepath.Join(t.TempDir(), "botlevi.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &LeviClient{
+		db:             db,
+		playerCache:    map[string]*trackedPlayer{},
+		reportedErrors: map[string]bool{},
+		recentPhrases:  map[string][]uint{},
+		groupAdmins:    map[string]groupAdmins{},
+		liveMatches:    map[string]liveMatch{},
+		pendingLeagues: map[string]*pendingLeague{},
+	}
+}
+
+func TestUpdateStateOfRemovedAccount(t *testing.T) {
+	c := newTestClient(t)
+	acc := Account{Name: "Keko", Puuid: "puuid-keko"}
+	state := TrackingState{Puuid: acc.Puuid, LastMatchId: "EUW1_1"}
+	c.db.Create(&acc)
+	c.db.Create(&state)
+	c.playerCache[acc.Puuid] = &trackedPlayer{acc, state}
+
+	player := c.trackedPlayers()[0]
+	c.updateState(player, func(state *TrackingState) { state.LastMatchId = "EUW1_2" })
+
+	if got := c.playerCache[acc.Puuid].state.LastMatchId; got != "EUW1_2" {
+		t.Fatalf("cached LastMatchId = %s, want EUW1_2", got)
+	}
+
+	// .removeaccount while the poll still has its copy
+	c.untrackAccount(acc)
+	c.updateState(player, func(state *TrackingState) { state.LastMatchId = "EUW1_3" })
+
+	var count int64
+	c.db.Unscoped().Model(&TrackingState{}).Where("puuid = ?", acc.Puuid).Count(&count)
+	if count != 0 {
+		t.Fatalf("the state of a removed account was saved again")
+	}
+	if player.state.LastMatchId != "EUW1_3" {
+		t.Fatalf("copy LastMatchId = %s, want EUW1_3", player.state.LastMatchId)
+	}
+}
+
+func TestNewMatchIds(t *testing.T) {
+	ids := []string{"EUW1_4", "EUW1_3", "EUW1_2", "EUW1_1"}
+
+	tests := []struct {
+		name        string
+		lastMatchId string
+		newIds      []string
+	}{
+		{"up to date", "EUW1_4", nil},
+		{"one new", "EUW1_3", []string{"EUW1_4"}},
+		{"several new", "EUW1_2", []string{"EUW1_3", "EUW1_4"}},
+		{"last one out of the page", "EUW1_0", []string{"EUW1_1", "EUW1_2", "EUW1_3", "EUW1_4"}},
+		{"first poll", "", []string{"EUW1_1", "EUW1_2", "EUW1_3", "EUW1_4"}},
+	}
+
+	for _, tt := range tests {
+		if got := newMatchIds(ids, tt.lastMatchId); !reflect.DeepEqual(got, tt.newIds) {
+			t.Errorf("%s: newMatchIds = %v, want %v", tt.name, got, tt.newIds)
+		}
+	}
+
+	if got := newMatchIds(nil, "EUW1_1"); got != nil {
+		t.Errorf("newMatchIds without ids = %v, want none", got)
+	}
+}
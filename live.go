@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// How often spectator is asked for the games of the tracked players.
+const liveCheckInterval = time.Minute
+
+// A player isn't checked again while in a game for this long, unless the
+// match shows up before. Long enough for any real game.
+const maxLiveGameLength = time.Minute * 90
+
+// "In game" messages whose result never came are dropped after this.
+const liveGameRetention = time.Hour * 24
+
+var teamNames = map[int]string{
+	100: "🔵 Equipo azul",
+	200: "🔴 Equipo rojo",
+}
+
+type liveMatch struct {
+	matchId string
+	seen    time.Time
+}
+
+// checkLiveGames looks for tracked players that just started a game and
+// tells the groups following them.
+func (c *LeviClient) checkLiveGames() {
+	c.db.Unscoped().Where("created_at < ?", time.Now().Add(-liveGameRetention)).Delete(&LiveGame{})
+
+	players := c.trackedPlayers()
+
+	for _, player := range players {
+		if live, ok := c.liveMatches[player.account.Puuid]; ok && time.Since(live.seen) < maxLiveGameLength {
+			continue
+		}
+
+		game, err := c.lolClient.GetActiveGameBySummoner(player.account.Platform, player.account.Id)
+
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				c.wppClient.Log.Errorf("Could not check the live game of %s: %s", player.account.RiotId(), err)
+			}
+			continue
+		}
+
+		// every tracked player in the game is covered by this one lookup
+		var inGame []*trackedPlayer
+		for _, p := range game.Participants {
+			for _, other := range players {
+				if p.SummonerID == other.account.Id {
+					inGame = append(inGame, other)
+					c.liveMatches[other.account.Puuid] = liveMatch{game.MatchId(), time.Now()}
+				}
+			}
+		}
+
+		c.announceLiveGame(game, inGame)
+	}
+}
+
+// liveGame returns the "in game" message sent to the group for a match.
+func (c *LeviClient) liveGame(group types.JID, matchId string) (LiveGame, bool) {
+	var live LiveGame
+	found := c.db.Where(&LiveGame{MatchId: matchId, GroupJID: group.String()}).Limit(1).Find(&live).RowsAffected > 0
+
+	return live, found
+}
+
+// announceLiveGame sends the "in game" message to every group following any
+// of the players, once per game.
+func (c *LeviClient) announceLiveGame(game CurrentGame, players []*trackedPlayer) {
+	matchId := game.MatchId()
+	ranks := map[string]string{}
+	groups, subs := c.matchSubscriptions(players)
+
+	for _, jid := range groups {
+		group, err := types.ParseJID(jid)
+		if err != nil {
+			continue
+		}
+
+		if _, announced := c.liveGame(group, matchId); announced {
+			continue
+		}
+
+		var announced []Account
+		for _, sub := range subs[jid] {
+			if !c.shouldAnnounce(group, sub, game.GameQueueConfigID) {
+				continue
+			}
+
+			for _, player := range players {
+				if player.account.Puuid == sub.Puuid {
+					announced = append(announced, player.account)
+				}
+			}
+		}
+
+		if len(announced) == 0 {
+			continue
+		}
+
+		msg := c.formatLiveGame(game, announced, ranks)
+		id := c.SendText(group, msg)
+
+		c.wppClient.Log.Infof("Announcing live game %s to %s", matchId, group)
+		c.db.Create(&LiveGame{MatchId: matchId, GroupJID: jid, MessageId: id, Text: msg})
+	}
+}
+
+// formatLiveGame lists both teams with the tracked players marked and the
+// Solo/Duo rank of their opponents, which is everyone else when they play
+// against each other. ranks caches the ranks already fetched for other
+// groups.
+func (c *LeviClient) formatLiveGame(game CurrentGame, accs []Account, ranks map[string]string) string {
+	tracked := map[string]Account{}
+	for _, acc := range accs {
+		tracked[acc.Id] = acc
+	}
+
+	// Names and champions come from the same participant, accs is in
+	// subscription order and may hold players that aren't in the game.
+	var names, champions []string
+	for _, p := range game.Participants {
+		if acc, ok := tracked[p.SummonerID]; ok {
+			names = append(names, acc.RiotId())
+			champions = append(champions, c.ddragon.ChampionName(p.ChampionID))
+		}
+	}
+
+	var header string
+	switch len(names) {
+	case 0:
+		header = fmt.Sprintf("Bot: 🎮 %s en curso", queueName(game.GameQueueConfigID))
+	case 1:
+		header = fmt.Sprintf(
+			"Bot: 🎮 %s acaba de entrar en una %s con %s",
+			names[0],
+			queueName(game.GameQueueConfigID),
+			champions[0],
+		)
+	default:
+		players := make([]string, len(names))
+		for i := range names {
+			players[i] = fmt.Sprintf("%s (%s)", names[i], champions[i])
+		}
+		header = fmt.Sprintf(
+			"Bot: 🎮 %s y %s acaban de entrar en una %s",
+			strings.Join(players[:len(players)-1], ", "),
+			players[len(players)-1],
+			queueName(game.GameQueueConfigID),
+		)
+	}
+
+	opponents := opponentTeams(game, tracked)
+
+	lines := []string{header}
+	for _, teamId := range []int{100, 200} {
+		lines = append(lines, "", teamNames[teamId]+":")
+
+		for _, p := range game.Participants {
+			if p.TeamID != teamId {
+				continue
+			}
+
+			line := fmt.Sprintf(" %s - %s", c.ddragon.ChampionName(p.ChampionID), p.DisplayName())
+
+			if _, ok := tracked[p.SummonerID]; ok {
+				line += " ⭐"
+			} else if opponents[p.TeamID] && !p.Bot {
+				line += fmt.Sprintf(" (%s)", c.soloRank(game.PlatformID, p.SummonerID, ranks))
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// opponentTeams returns the teams facing at least one tracked player.
+func opponentTeams(game CurrentGame, tracked map[string]Account) map[int]bool {
+	opponents := map[int]bool{}
+
+	for _, p := range game.Participants {
+		if _, ok := tracked[p.SummonerID]; !ok {
+			continue
+		}
+
+		for _, teamId := range []int{100, 200} {
+			if teamId != p.TeamID {
+				opponents[teamId] = true
+			}
+		}
+	}
+
+	return opponents
+}
+
+// soloRank returns the Solo/Duo rank of a summoner, cached in ranks.
+func (c *LeviClient) soloRank(platform, summonerId string, ranks map[string]string) string {
+	if rank, ok := ranks[summonerId]; ok {
+		return rank
+	}
+
+	rank := "sin rankear"
+	leagues, err := c.lolClient.GetLeagueBySummonerId(strings.ToLower(platform), summonerId)
+
+	if err != nil {
+		rank = "rango desconocido"
+	} else if league, ok := findLeague(leagues, soloQueueType); ok {
+		rank = formatRank(league)
+	}
+
+	ranks[summonerId] = rank
+	return rank
+}
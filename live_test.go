@@ -0,0 +1,82 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOpponentTeams(t *testing.T) {
+	game := CurrentGame{}
+	for i, teamId := range []int{100, 100, 100, 100, 100, 200, 200, 200, 200, 200} {
+		game.Participants = append(game.Participants, CurrentGameParticipant{
+			TeamID:     teamId,
+			SummonerID: string(rune('a' + i)),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		tracked   []string
+		opponents map[int]bool
+	}{
+		{"blue", []string{"a"}, map[int]bool{200: true}},
+		{"red duo", []string{"f", "g"}, map[int]bool{100: true}},
+		{"both teams", []string{"a", "j"}, map[int]bool{100: true, 200: true}},
+		{"not in game", []string{"z"}, map[int]bool{}},
+	}
+
+	for _, tt := range tests {
+		tracked := map[string]Account{}
+		for _, id := range tt.tracked {
+			tracked[id] = Account{Id: id}
+		}
+
+		if got := opponentTeams(game, tracked); !reflect.DeepEqual(got, tt.opponents) {
+			t.Errorf("%s: opponentTeams = %v, want %v", tt.name, got, tt.opponents)
+		}
+	}
+}
+
+func TestFormatLiveGameHeader(t *testing.T) {
+	c := &LeviClient{ddragon: testDataDragon(t, notFound)}
+
+	game := CurrentGame{GameQueueConfigID: 420}
+	ranks := map[string]string{}
+	for i, champion := range []int{157, 64, 25, 222, 412, 122, 103, 32, 81, 99} {
+		id := string(rune('a' + i))
+		teamId := 100
+		if i >= 5 {
+			teamId = 200
+		}
+
+		game.Participants = append(game.Participants, CurrentGameParticipant{
+			TeamID:     teamId,
+			ChampionID: champion,
+			SummonerID: id,
+		})
+		ranks[id] = "sin rankear"
+	}
+
+	keko := Account{Id: "a", GameName: "Keko", TagLine: "EUW"}
+	gibe := Account{Id: "b", GameName: "Gibe", TagLine: "EUW"}
+	away := Account{Id: "z", GameName: "Away", TagLine: "EUW"}
+
+	tests := []struct {
+		name   string
+		accs   []Account
+		header string
+	}{
+		{"one", []Account{keko}, "Bot: 🎮 Keko#EUW acaba de entrar en una Ranked Solo/Duo con Yasuo"},
+		{"one after someone not in game", []Account{away, gibe}, "Bot: 🎮 Gibe#EUW acaba de entrar en una Ranked Solo/Duo con Lee Sin"},
+		{"two in subscription order", []Account{gibe, keko}, "Bot: 🎮 Keko#EUW (Yasuo) y Gibe#EUW (Lee Sin) acaban de entrar en una Ranked Solo/Duo"},
+		{"none in game", []Account{away}, "Bot: 🎮 Ranked Solo/Duo en curso"},
+	}
+
+	for _, tt := range tests {
+		msg := c.formatLiveGame(game, tt.accs, ranks)
+		if header := strings.SplitN(msg, "\n", 2)[0]; header != tt.header {
+			t.Errorf("%s: header = %q, want %q", tt.name, header, tt.header)
+		}
+	}
+}
@@ -2,30 +2,82 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
+// Base urls, %s is replaced with the platform (euw1, na1..) or the regional
+// cluster (europe, americas..). Urls without %s are used as they are, which
+// allows pointing every call to a local mock.
 const (
-	lolRequestUrl   = "https://euw1.api.riotgames.com"
-	matchRequestUrl = "https://europe.api.riotgames.com"
+	defaultPlatformUrl = "https://%s.api.riotgames.com"
+	defaultRegionalUrl = "https://%s.api.riotgames.com"
 )
 
+// Maximum times a request is retried after Riot answers with a 429.
+const maxRateLimitRetries = 3
+
 type LolClient struct {
-	apiKey     string
-	httpClient *http.Client
+	apiKey      string
+	httpClient  *http.Client
+	rateLimiter *RateLimiter
+	platformUrl string
+	regionalUrl string
+}
+
+// NewLolClient creates a client for the Riot API, empty urls fall back to
+// the official ones.
+func NewLolClient(apiKey, platformUrl, regionalUrl string) *LolClient {
+	if platformUrl == "" {
+		platformUrl = defaultPlatformUrl
+	}
+
+	if regionalUrl == "" {
+		regionalUrl = defaultRegionalUrl
+	}
+
+	return &LolClient{apiKey, &http.Client{}, NewRateLimiter(), platformUrl, regionalUrl}
+}
+
+func routingUrl(baseUrl, routing string) string {
+	if strings.Contains(baseUrl, "%s") {
+		return fmt.Sprintf(baseUrl, routing)
+	}
+
+	return baseUrl
 }
 
-func NewLolClient(apiKey string) *LolClient {
-	return &LolClient{apiKey, &http.Client{}}
+// do sends the request once the rate limiter allows it, queueing the caller
+// and retrying when Riot still answers with a 429. Limits are tracked per
+// routing value so a mock serving every platform keeps separate buckets.
+func (c *LolClient) do(routing, method string, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		c.rateLimiter.Wait(routing, method)
+
+		res, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.rateLimiter.Update(routing, method, res)
+
+		if res.StatusCode != http.StatusTooManyRequests || attempt >= maxRateLimitRetries {
+			return res, nil
+		}
+
+		res.Body.Close()
+	}
 }
 
-func (c *LolClient) GetLeagueBySummonerId(summonerId string) ([]League, error) {
+func (c *LolClient) GetLeagueBySummonerId(platform, summonerId string) ([]League, error) {
 	req, err := http.NewRequest(
 		http.MethodGet,
 		strings.Join(
-			[]string{lolRequestUrl, "/lol/league/v4/entries/by-summoner/", summonerId},
+			[]string{routingUrl(c.platformUrl, platform), "/lol/league/v4/entries/by-summoner/", summonerId},
 			"",
 		),
 		nil,
@@ -36,12 +88,18 @@ func (c *LolClient) GetLeagueBySummonerId(summonerId string) ([]League, error) {
 
 	req.Header.Set("X-Riot-Token", c.apiKey)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.do(platform, "league-v4.entries-by-summoner", req)
 
 	if err != nil {
 		return []League{}, err
 	}
 
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return []League{}, err
+	}
+
 	body, err := ioutil.ReadAll(res.Body)
 
 	if err != nil {
@@ -58,11 +116,11 @@ func (c *LolClient) GetLeagueBySummonerId(summonerId string) ([]League, error) {
 	return leagues, nil
 }
 
-func (c *LolClient) GetSummonerByName(summonerName string) (Summoner, error) {
+func (c *LolClient) GetSummonerByName(platform, summonerName string) (Summoner, error) {
 	req, err := http.NewRequest(
 		http.MethodGet,
 		strings.Join(
-			[]string{lolRequestUrl, "/lol/summoner/v4/summoners/by-name/", summonerName},
+			[]string{routingUrl(c.platformUrl, platform), "/lol/summoner/v4/summoners/by-name/", summonerName},
 			"",
 		),
 		nil,
@@ -73,12 +131,18 @@ func (c *LolClient) GetSummonerByName(summonerName string) (Summoner, error) {
 
 	req.Header.Set("X-Riot-Token", c.apiKey)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.do(platform, "summoner-v4.by-name", req)
 
 	if err != nil {
 		return Summoner{}, err
 	}
 
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return Summoner{}, err
+	}
+
 	body, err := ioutil.ReadAll(res.Body)
 
 	if err != nil {
@@ -95,15 +159,65 @@ func (c *LolClient) GetSummonerByName(summonerName string) (Summoner, error) {
 	return summoner, nil
 }
 
-func (c *LolClient) GetLastMatchId(puuid string) (string, error) {
+func (c *LolClient) GetAccountByRiotId(platform, gameName, tagLine string) (RiotAccount, error) {
+	region := accountRegionForPlatform(platform)
 	req, err := http.NewRequest(
 		http.MethodGet,
 		strings.Join(
 			[]string{
-				matchRequestUrl,
-				"/lol/match/v5/matches/by-puuid/",
+				routingUrl(c.regionalUrl, region),
+				"/riot/account/v1/accounts/by-riot-id/",
+				url.PathEscape(gameName),
+				"/",
+				url.PathEscape(tagLine),
+			},
+			"",
+		),
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("X-Riot-Token", c.apiKey)
+
+	res, err := c.do(region, "account-v1.by-riot-id", req)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return RiotAccount{}, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	var account RiotAccount
+	err = json.Unmarshal(body, &account)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	return account, nil
+}
+
+func (c *LolClient) GetAccountByPuuid(platform, puuid string) (RiotAccount, error) {
+	region := accountRegionForPlatform(platform)
+	req, err := http.NewRequest(
+		http.MethodGet,
+		strings.Join(
+			[]string{
+				routingUrl(c.regionalUrl, region),
+				"/riot/account/v1/accounts/by-puuid/",
 				puuid,
-				"/ids?start=0&count=1",
 			},
 			"",
 		),
@@ -115,24 +229,160 @@ func (c *LolClient) GetLastMatchId(puuid string) (string, error) {
 
 	req.Header.Set("X-Riot-Token", c.apiKey)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.do(region, "account-v1.by-puuid", req)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return RiotAccount{}, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	var account RiotAccount
+	err = json.Unmarshal(body, &account)
+
+	if err != nil {
+		return RiotAccount{}, err
+	}
+
+	return account, nil
+}
+
+func (c *LolClient) GetSummonerByPuuid(platform, puuid string) (Summoner, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		strings.Join(
+			[]string{
+				routingUrl(c.platformUrl, platform),
+				"/lol/summoner/v4/summoners/by-puuid/",
+				puuid,
+			},
+			"",
+		),
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("X-Riot-Token", c.apiKey)
+
+	res, err := c.do(platform, "summoner-v4.by-puuid", req)
+
+	if err != nil {
+		return Summoner{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return Summoner{}, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return Summoner{}, err
+	}
+
+	var summoner Summoner
+	err = json.Unmarshal(body, &summoner)
+
+	if err != nil {
+		return Summoner{}, err
+	}
+
+	return summoner, nil
+}
+
+func (c *LolClient) GetLastMatchId(platform, puuid string) (string, error) {
+	ids, err := c.GetMatchIds(platform, puuid, 0, 1)
 
 	if err != nil {
 		return "", err
 	}
 
+	if len(ids) == 0 {
+		return "", ErrNoMatches
+	}
+
+	return ids[0], nil
+}
+
+// GetMatchIds returns up to count match ids, newest first, of games started
+// after startTime (epoch seconds). A zero startTime doesn't filter by time.
+func (c *LolClient) GetMatchIds(platform, puuid string, startTime int64, count int) ([]string, error) {
+	region := regionForPlatform(platform)
+
+	query := url.Values{}
+	query.Set("start", "0")
+	query.Set("count", strconv.Itoa(count))
+	if startTime > 0 {
+		query.Set("startTime", strconv.FormatInt(startTime, 10))
+	}
+
+	req, err := http.NewRequest(
+		http.MethodGet,
+		strings.Join(
+			[]string{
+				routingUrl(c.regionalUrl, region),
+				"/lol/match/v5/matches/by-puuid/",
+				puuid,
+				"/ids?",
+				query.Encode(),
+			},
+			"",
+		),
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("X-Riot-Token", c.apiKey)
+
+	res, err := c.do(region, "match-v5.ids-by-puuid", req)
+
+	if err != nil {
+		return []string{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return []string{}, err
+	}
+
 	var ids []string
 	body, err := ioutil.ReadAll(res.Body)
-	json.Unmarshal(body, &ids)
 
-	return ids[0], err
+	if err != nil {
+		return []string{}, err
+	}
+
+	err = json.Unmarshal(body, &ids)
 
+	if err != nil {
+		return []string{}, err
+	}
+
+	return ids, nil
 }
 
 func (c *LolClient) GetMatchById(id string) (Match, error) {
+	region := regionForPlatform(platformFromMatchId(id))
 	req, err := http.NewRequest(
 		http.MethodGet,
-		strings.Join([]string{matchRequestUrl, "/lol/match/v5/matches/", id}, ""),
+		strings.Join([]string{routingUrl(c.regionalUrl, region), "/lol/match/v5/matches/", id}, ""),
 		nil,
 	)
 	if err != nil {
@@ -141,12 +391,18 @@ func (c *LolClient) GetMatchById(id string) (Match, error) {
 
 	req.Header.Set("X-Riot-Token", c.apiKey)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.do(region, "match-v5.match", req)
 
 	if err != nil {
 		return Match{}, err
 	}
 
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return Match{}, err
+	}
+
 	body, err := ioutil.ReadAll(res.Body)
 
 	if err != nil {
@@ -164,6 +420,94 @@ func (c *LolClient) GetMatchById(id string) (Match, error) {
 
 }
 
+// GetMatchTimeline returns the minute by minute frames and events of a
+// match, see timeline.go.
+func (c *LolClient) GetMatchTimeline(id string) (MatchTimeline, error) {
+	region := regionForPlatform(platformFromMatchId(id))
+	req, err := http.NewRequest(
+		http.MethodGet,
+		strings.Join([]string{routingUrl(c.regionalUrl, region), "/lol/match/v5/matches/", id, "/timeline"}, ""),
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("X-Riot-Token", c.apiKey)
+
+	res, err := c.do(region, "match-v5.timeline", req)
+
+	if err != nil {
+		return MatchTimeline{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return MatchTimeline{}, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return MatchTimeline{}, err
+	}
+
+	var timeline MatchTimeline
+	err = json.Unmarshal(body, &timeline)
+
+	if err != nil {
+		return MatchTimeline{}, err
+	}
+
+	return timeline, nil
+}
+
+// GetActiveGameBySummoner returns the game the summoner is playing right
+// now, ErrNotFound means they aren't in one.
+func (c *LolClient) GetActiveGameBySummoner(platform, summonerId string) (CurrentGame, error) {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		strings.Join(
+			[]string{routingUrl(c.platformUrl, platform), "/lol/spectator/v4/active-games/by-summoner/", summonerId},
+			"",
+		),
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("X-Riot-Token", c.apiKey)
+
+	res, err := c.do(platform, "spectator-v4.active-games-by-summoner", req)
+
+	if err != nil {
+		return CurrentGame{}, err
+	}
+
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return CurrentGame{}, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+
+	if err != nil {
+		return CurrentGame{}, err
+	}
+
+	var game CurrentGame
+	err = json.Unmarshal(body, &game)
+
+	if err != nil {
+		return CurrentGame{}, err
+	}
+
+	return game, nil
+}
+
 //////////////////
 
 //////////////////
@@ -186,6 +530,12 @@ type Summoner struct {
 	SummonerLevel int    `json:"summonerLevel"`
 }
 
+type RiotAccount struct {
+	Puuid    string `json:"puuid"`
+	GameName string `json:"gameName"`
+	TagLine  string `json:"tagLine"`
+}
+
 type League struct {
 	LeagueID     string `json:"leagueId"`
 	QueueType    string `json:"queueType"`
@@ -208,6 +558,51 @@ type League struct {
 	} `json:"miniSeries"`
 }
 
+type CurrentGame struct {
+	GameID            int64  `json:"gameId"`
+	GameType          string `json:"gameType"`
+	GameStartTime     int64  `json:"gameStartTime"`
+	MapID             int    `json:"mapId"`
+	GameLength        int    `json:"gameLength"`
+	PlatformID        string `json:"platformId"`
+	GameMode          string `json:"gameMode"`
+	GameQueueConfigID int    `json:"gameQueueConfigId"`
+	BannedChampions   []struct {
+		PickTurn   int `json:"pickTurn"`
+		ChampionID int `json:"championId"`
+		TeamID     int `json:"teamId"`
+	} `json:"bannedChampions"`
+	Participants []CurrentGameParticipant `json:"participants"`
+}
+
+type CurrentGameParticipant struct {
+	ChampionID    int    `json:"championId"`
+	ProfileIconID int    `json:"profileIconId"`
+	Bot           bool   `json:"bot"`
+	TeamID        int    `json:"teamId"`
+	SummonerName  string `json:"summonerName"`
+	SummonerID    string `json:"summonerId"`
+	Puuid         string `json:"puuid"`
+	RiotID        string `json:"riotId"`
+	Spell1ID      int    `json:"spell1Id"`
+	Spell2ID      int    `json:"spell2Id"`
+}
+
+// DisplayName is the riot id of the player when Riot sends it, the old
+// summoner name otherwise.
+func (p CurrentGameParticipant) DisplayName() string {
+	if p.RiotID != "" {
+		return p.RiotID
+	}
+
+	return p.SummonerName
+}
+
+// MatchId is the match-v5 id the game will have once it ends.
+func (g CurrentGame) MatchId() string {
+	return fmt.Sprintf("%s_%d", strings.ToUpper(g.PlatformID), g.GameID)
+}
+
 type Match struct {
 	Metadata struct {
 		DataVersion  string   `json:"dataVersion"`
@@ -215,1309 +610,322 @@ type Match struct {
 		Participants []string `json:"participants"`
 	} `json:"metadata"`
 	Info struct {
-		GameCreation       int64  `json:"gameCreation"`
-		GameDuration       int    `json:"gameDuration"`
-		GameEndTimestamp   int64  `json:"gameEndTimestamp"`
-		GameID             int64  `json:"gameId"`
-		GameMode           string `json:"gameMode"`
-		GameName           string `json:"gameName"`
-		GameStartTimestamp int64  `json:"gameStartTimestamp"`
-		GameType           string `json:"gameType"`
-		GameVersion        string `json:"gameVersion"`
-		MapID              int    `json:"mapId"`
-		Participants       []struct {
-			AllInPings    int `json:"allInPings"`
-			AssistMePings int `json:"assistMePings"`
-			Assists       int `json:"assists"`
-			BaitPings     int `json:"baitPings"`
-			BaronKills    int `json:"baronKills"`
-			BasicPings    int `json:"basicPings"`
-			BountyLevel   int `json:"bountyLevel"`
-			Challenges    struct {
-				One2AssistStreakCount                    int     `json:"12AssistStreakCount"`
-				AbilityUses                              int     `json:"abilityUses"`
-				AcesBefore15Minutes                      int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                 int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                           int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount           int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                               int     `json:"bountyGold"`
-				BuffsStolen                              int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime               int     `json:"completeSupportQuestInTime"`
-				ControlWardsPlaced                       int     `json:"controlWardsPlaced"`
-				DamagePerMinute                          float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage              float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                     int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                      int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow               int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                               int     `json:"doubleAces"`
-				DragonTakedowns                          int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage         int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul         int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                    int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations             int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                  int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler         int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn   int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                        int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite            int     `json:"epicMonsterStolenWithoutSmite"`
-				FlawlessAces                             int     `json:"flawlessAces"`
-				FullTeamTakedown                         int     `json:"fullTeamTakedown"`
-				GameLength                               float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                            float64 `json:"goldPerMinute"`
-				HadOpenNexus                             int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                         int     `json:"initialBuffCount"`
-				InitialCrabCount                         int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                  int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster   int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall        int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                      float64 `json:"kda"`
-				KillAfterHiddenWithAlly                  int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                        float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived    int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                     int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner      int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack          int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                      int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster             int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                  int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage              int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                           int     `json:"legendaryCount"`
-				LostAnInhibitor                          int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent             int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                           int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                 int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent              int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                        int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount               int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                               int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash           int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                           int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes       int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                         int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                     int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                  int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                              int     `json:"perfectGame"`
-				PickKillWithAlly                         int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                int     `json:"playedChampSelectPosition"`
-				PoroExplosions                           int     `json:"poroExplosions"`
-				QuickCleanse                             int     `json:"quickCleanse"`
-				QuickFirstTurret                         int     `json:"quickFirstTurret"`
-				QuickSoloKills                           int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                      int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                        int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                         int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                         int     `json:"skillshotsDodged"`
-				SkillshotsHit                            int     `json:"skillshotsHit"`
-				SnowballsHit                             int     `json:"snowballsHit"`
-				SoloBaronKills                           int     `json:"soloBaronKills"`
-				SoloKills                                int     `json:"soloKills"`
-				StealthWardsPlaced                       int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount               int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight          int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                    int     `json:"takedownOnFirstTurret"`
-				Takedowns                                int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage      int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn         int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                   int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                        int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                 int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                           int     `json:"teamBaronKills"`
-				TeamDamagePercentage                     float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                     int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                      int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                  int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                        int     `json:"turretPlatesTaken"`
-				TurretTakedowns                          int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald               int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount             int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                            int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent         float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                     float64 `json:"visionScorePerMinute"`
-				WardTakedowns                            int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                   int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                             int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			ChampExperience             int    `json:"champExperience"`
-			ChampLevel                  int    `json:"champLevel"`
-			ChampionID                  int    `json:"championId"`
-			ChampionName                string `json:"championName"`
-			ChampionTransform           int    `json:"championTransform"`
-			CommandPings                int    `json:"commandPings"`
-			ConsumablesPurchased        int    `json:"consumablesPurchased"`
-			DamageDealtToBuildings      int    `json:"damageDealtToBuildings"`
-			DamageDealtToObjectives     int    `json:"damageDealtToObjectives"`
-			DamageDealtToTurrets        int    `json:"damageDealtToTurrets"`
-			DamageSelfMitigated         int    `json:"damageSelfMitigated"`
-			DangerPings                 int    `json:"dangerPings"`
-			Deaths                      int    `json:"deaths"`
-			DetectorWardsPlaced         int    `json:"detectorWardsPlaced"`
-			DoubleKills                 int    `json:"doubleKills"`
-			DragonKills                 int    `json:"dragonKills"`
-			EligibleForProgression      bool   `json:"eligibleForProgression"`
-			EnemyMissingPings           int    `json:"enemyMissingPings"`
-			EnemyVisionPings            int    `json:"enemyVisionPings"`
-			FirstBloodAssist            bool   `json:"firstBloodAssist"`
-			FirstBloodKill              bool   `json:"firstBloodKill"`
-			FirstTowerAssist            bool   `json:"firstTowerAssist"`
-			FirstTowerKill              bool   `json:"firstTowerKill"`
-			GameEndedInEarlySurrender   bool   `json:"gameEndedInEarlySurrender"`
-			GameEndedInSurrender        bool   `json:"gameEndedInSurrender"`
-			GetBackPings                int    `json:"getBackPings"`
-			GoldEarned                  int    `json:"goldEarned"`
-			GoldSpent                   int    `json:"goldSpent"`
-			HoldPings                   int    `json:"holdPings"`
-			IndividualPosition          string `json:"individualPosition"`
-			InhibitorKills              int    `json:"inhibitorKills"`
-			InhibitorTakedowns          int    `json:"inhibitorTakedowns"`
-			InhibitorsLost              int    `json:"inhibitorsLost"`
-			Item0                       int    `json:"item0"`
-			Item1                       int    `json:"item1"`
-			Item2                       int    `json:"item2"`
-			Item3                       int    `json:"item3"`
-			Item4                       int    `json:"item4"`
-			Item5                       int    `json:"item5"`
-			Item6                       int    `json:"item6"`
-			ItemsPurchased              int    `json:"itemsPurchased"`
-			KillingSprees               int    `json:"killingSprees"`
-			Kills                       int    `json:"kills"`
-			Lane                        string `json:"lane"`
-			LargestCriticalStrike       int    `json:"largestCriticalStrike"`
-			LargestKillingSpree         int    `json:"largestKillingSpree"`
-			LargestMultiKill            int    `json:"largestMultiKill"`
-			LongestTimeSpentLiving      int    `json:"longestTimeSpentLiving"`
-			MagicDamageDealt            int    `json:"magicDamageDealt"`
-			MagicDamageDealtToChampions int    `json:"magicDamageDealtToChampions"`
-			MagicDamageTaken            int    `json:"magicDamageTaken"`
-			NeedVisionPings             int    `json:"needVisionPings"`
-			NeutralMinionsKilled        int    `json:"neutralMinionsKilled"`
-			NexusKills                  int    `json:"nexusKills"`
-			NexusLost                   int    `json:"nexusLost"`
-			NexusTakedowns              int    `json:"nexusTakedowns"`
-			ObjectivesStolen            int    `json:"objectivesStolen"`
-			ObjectivesStolenAssists     int    `json:"objectivesStolenAssists"`
-			OnMyWayPings                int    `json:"onMyWayPings"`
-			ParticipantID               int    `json:"participantId"`
-			PentaKills                  int    `json:"pentaKills"`
-			Perks                       struct {
-				StatPerks struct {
-					Defense int `json:"defense"`
-					Flex    int `json:"flex"`
-					Offense int `json:"offense"`
-				} `json:"statPerks"`
-				Styles []struct {
-					Description string `json:"description"`
-					Selections  []struct {
-						Perk int `json:"perk"`
-						Var1 int `json:"var1"`
-						Var2 int `json:"var2"`
-						Var3 int `json:"var3"`
-					} `json:"selections"`
-					Style int `json:"style"`
-				} `json:"styles"`
-			} `json:"perks"`
-			PhysicalDamageDealt            int    `json:"physicalDamageDealt"`
-			PhysicalDamageDealtToChampions int    `json:"physicalDamageDealtToChampions"`
-			PhysicalDamageTaken            int    `json:"physicalDamageTaken"`
-			ProfileIcon                    int    `json:"profileIcon"`
-			PushPings                      int    `json:"pushPings"`
-			Puuid                          string `json:"puuid"`
-			QuadraKills                    int    `json:"quadraKills"`
-			RiotIDName                     string `json:"riotIdName"`
-			RiotIDTagline                  string `json:"riotIdTagline"`
-			Role                           string `json:"role"`
-			SightWardsBoughtInGame         int    `json:"sightWardsBoughtInGame"`
-			Spell1Casts                    int    `json:"spell1Casts"`
-			Spell2Casts                    int    `json:"spell2Casts"`
-			Spell3Casts                    int    `json:"spell3Casts"`
-			Spell4Casts                    int    `json:"spell4Casts"`
-			Summoner1Casts                 int    `json:"summoner1Casts"`
-			Summoner1ID                    int    `json:"summoner1Id"`
-			Summoner2Casts                 int    `json:"summoner2Casts"`
-			Summoner2ID                    int    `json:"summoner2Id"`
-			SummonerID                     string `json:"summonerId"`
-			SummonerLevel                  int    `json:"summonerLevel"`
-			SummonerName                   string `json:"summonerName"`
-			TeamEarlySurrendered           bool   `json:"teamEarlySurrendered"`
-			TeamID                         int    `json:"teamId"`
-			TeamPosition                   string `json:"teamPosition"`
-			TimeCCingOthers                int    `json:"timeCCingOthers"`
-			TimePlayed                     int    `json:"timePlayed"`
-			TotalDamageDealt               int    `json:"totalDamageDealt"`
-			TotalDamageDealtToChampions    int    `json:"totalDamageDealtToChampions"`
-			TotalDamageShieldedOnTeammates int    `json:"totalDamageShieldedOnTeammates"`
-			TotalDamageTaken               int    `json:"totalDamageTaken"`
-			TotalHeal                      int    `json:"totalHeal"`
-			TotalHealsOnTeammates          int    `json:"totalHealsOnTeammates"`
-			TotalMinionsKilled             int    `json:"totalMinionsKilled"`
-			TotalTimeCCDealt               int    `json:"totalTimeCCDealt"`
-			TotalTimeSpentDead             int    `json:"totalTimeSpentDead"`
-			TotalUnitsHealed               int    `json:"totalUnitsHealed"`
-			TripleKills                    int    `json:"tripleKills"`
-			TrueDamageDealt                int    `json:"trueDamageDealt"`
-			TrueDamageDealtToChampions     int    `json:"trueDamageDealtToChampions"`
-			TrueDamageTaken                int    `json:"trueDamageTaken"`
-			TurretKills                    int    `json:"turretKills"`
-			TurretTakedowns                int    `json:"turretTakedowns"`
-			TurretsLost                    int    `json:"turretsLost"`
-			UnrealKills                    int    `json:"unrealKills"`
-			VisionClearedPings             int    `json:"visionClearedPings"`
-			VisionScore                    int    `json:"visionScore"`
-			VisionWardsBoughtInGame        int    `json:"visionWardsBoughtInGame"`
-			WardsKilled                    int    `json:"wardsKilled"`
-			WardsPlaced                    int    `json:"wardsPlaced"`
-			Win                            bool   `json:"win"`
-			Challenges0                    struct {
-				One2AssistStreakCount                  int     `json:"12AssistStreakCount"`
-				AbilityUses                            int     `json:"abilityUses"`
-				AcesBefore15Minutes                    int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills               int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                         int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount         int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                             int     `json:"bountyGold"`
-				BuffsStolen                            int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime             int     `json:"completeSupportQuestInTime"`
-				ControlWardsPlaced                     int     `json:"controlWardsPlaced"`
-				DamagePerMinute                        float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage            float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                   int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                    int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow             int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                             int     `json:"doubleAces"`
-				DragonTakedowns                        int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage       int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding              int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul       int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                  int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations           int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler       int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                      int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite          int     `json:"epicMonsterStolenWithoutSmite"`
-				FlawlessAces                           int     `json:"flawlessAces"`
-				FullTeamTakedown                       int     `json:"fullTeamTakedown"`
-				GameLength                             float64 `json:"gameLength"`
-				GoldPerMinute                          float64 `json:"goldPerMinute"`
-				HadOpenNexus                           int     `json:"hadOpenNexus"`
-				HighestWardKills                       int     `json:"highestWardKills"`
-				ImmobilizeAndKillWithAlly              int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                       int     `json:"initialBuffCount"`
-				InitialCrabCount                       int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                float64 `json:"jungleCsBefore10Minutes"`
-				JunglerKillsEarlyJungle                int     `json:"junglerKillsEarlyJungle"`
-				JunglerTakedownsNearDamagedEpicMonster int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall      int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                    float64 `json:"kda"`
-				KillAfterHiddenWithAlly                int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                      float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived  int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                   int     `json:"killsNearEnemyTurret"`
-				KillsOnLanersEarlyJungleAsJungler      int     `json:"killsOnLanersEarlyJungleAsJungler"`
-				KillsOnRecentlyHealedByAramPack        int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                    int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster           int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill              int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes              int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage            int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                         int     `json:"legendaryCount"`
-				LostAnInhibitor                        int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent           float64 `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                         int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent               int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent            float64 `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                      int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount             int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                             int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash         int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                         int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes     int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                       int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                   int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                            int     `json:"perfectGame"`
-				PickKillWithAlly                       int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition              int     `json:"playedChampSelectPosition"`
-				PoroExplosions                         int     `json:"poroExplosions"`
-				QuickCleanse                           int     `json:"quickCleanse"`
-				QuickFirstTurret                       int     `json:"quickFirstTurret"`
-				QuickSoloKills                         int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                    int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                      int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                       int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                       int     `json:"skillshotsDodged"`
-				SkillshotsHit                          int     `json:"skillshotsHit"`
-				SnowballsHit                           int     `json:"snowballsHit"`
-				SoloBaronKills                         int     `json:"soloBaronKills"`
-				SoloKills                              int     `json:"soloKills"`
-				StealthWardsPlaced                     int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount             int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight        int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                  int     `json:"takedownOnFirstTurret"`
-				Takedowns                              int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage    int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn       int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                 int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                      int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain               int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                         int     `json:"teamBaronKills"`
-				TeamDamagePercentage                   float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                   int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                    int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount              int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                      int     `json:"turretPlatesTaken"`
-				TurretTakedowns                        int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald             int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount           int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                          int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent       float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                   float64 `json:"visionScorePerMinute"`
-				WardTakedowns                          int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                 int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                           int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges1 struct {
-				One2AssistStreakCount                    int     `json:"12AssistStreakCount"`
-				AbilityUses                              int     `json:"abilityUses"`
-				AcesBefore15Minutes                      int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                 int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                           int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount           int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                               int     `json:"bountyGold"`
-				BuffsStolen                              int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime               int     `json:"completeSupportQuestInTime"`
-				ControlWardsPlaced                       int     `json:"controlWardsPlaced"`
-				DamagePerMinute                          float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage              float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                     int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                      int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow               int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                               int     `json:"doubleAces"`
-				DragonTakedowns                          int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage         int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul         int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                    int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations             int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                  int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler         int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn   int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                        int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite            int     `json:"epicMonsterStolenWithoutSmite"`
-				FlawlessAces                             int     `json:"flawlessAces"`
-				FullTeamTakedown                         int     `json:"fullTeamTakedown"`
-				GameLength                               float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                            float64 `json:"goldPerMinute"`
-				HadOpenNexus                             int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                         int     `json:"initialBuffCount"`
-				InitialCrabCount                         int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                  int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster   int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall        int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                      float64 `json:"kda"`
-				KillAfterHiddenWithAlly                  int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                        float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived    int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillingSprees                            int     `json:"killingSprees"`
-				KillsNearEnemyTurret                     int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner      int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack          int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                      int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster             int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                  int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage              int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                           int     `json:"legendaryCount"`
-				LostAnInhibitor                          int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent             int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                           int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                 int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent              int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                        int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount               int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                               int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash           int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                           int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes       int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                         int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                     int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                  int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                              int     `json:"perfectGame"`
-				PickKillWithAlly                         int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                int     `json:"playedChampSelectPosition"`
-				PoroExplosions                           int     `json:"poroExplosions"`
-				QuickCleanse                             int     `json:"quickCleanse"`
-				QuickFirstTurret                         int     `json:"quickFirstTurret"`
-				QuickSoloKills                           int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                      int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                        int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                         int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                         int     `json:"skillshotsDodged"`
-				SkillshotsHit                            int     `json:"skillshotsHit"`
-				SnowballsHit                             int     `json:"snowballsHit"`
-				SoloBaronKills                           int     `json:"soloBaronKills"`
-				SoloKills                                int     `json:"soloKills"`
-				StealthWardsPlaced                       int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount               int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight          int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                    int     `json:"takedownOnFirstTurret"`
-				Takedowns                                int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage      int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn         int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                   int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                        int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                 int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                           int     `json:"teamBaronKills"`
-				TeamDamagePercentage                     float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                     int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                      int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                  int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                        int     `json:"turretPlatesTaken"`
-				TurretTakedowns                          int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald               int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount             int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                            int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent         float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                     float64 `json:"visionScorePerMinute"`
-				WardTakedowns                            int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                   int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                             int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges2 struct {
-				One2AssistStreakCount                    int     `json:"12AssistStreakCount"`
-				AbilityUses                              int     `json:"abilityUses"`
-				AcesBefore15Minutes                      int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                 int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                           int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount           int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                               int     `json:"bountyGold"`
-				BuffsStolen                              int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime               int     `json:"completeSupportQuestInTime"`
-				ControlWardsPlaced                       int     `json:"controlWardsPlaced"`
-				DamagePerMinute                          float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage              float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                     int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                      int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow               int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                               int     `json:"doubleAces"`
-				DragonTakedowns                          int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage         int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul         int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                    int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations             int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                  int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler         int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn   int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                        int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite            int     `json:"epicMonsterStolenWithoutSmite"`
-				FlawlessAces                             int     `json:"flawlessAces"`
-				FullTeamTakedown                         int     `json:"fullTeamTakedown"`
-				GameLength                               float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                            float64 `json:"goldPerMinute"`
-				HadOpenNexus                             int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                         int     `json:"initialBuffCount"`
-				InitialCrabCount                         int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                  int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster   int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall        int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                      float64 `json:"kda"`
-				KillAfterHiddenWithAlly                  int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                        float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived    int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                     int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner      int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack          int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                      int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster             int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                  int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage              int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                           int     `json:"legendaryCount"`
-				LostAnInhibitor                          int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent             int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                           int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                 int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent              int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                        int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount               int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                               int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash           int     `json:"multikillsAfterAggressiveFlash"`
-				OuterTurretExecutesBefore10Minutes       int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                         int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                     int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                  int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                              int     `json:"perfectGame"`
-				PickKillWithAlly                         int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                int     `json:"playedChampSelectPosition"`
-				PoroExplosions                           int     `json:"poroExplosions"`
-				QuickCleanse                             int     `json:"quickCleanse"`
-				QuickFirstTurret                         int     `json:"quickFirstTurret"`
-				QuickSoloKills                           int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                      int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                        int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                         int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                         int     `json:"skillshotsDodged"`
-				SkillshotsHit                            int     `json:"skillshotsHit"`
-				SnowballsHit                             int     `json:"snowballsHit"`
-				SoloBaronKills                           int     `json:"soloBaronKills"`
-				SoloKills                                int     `json:"soloKills"`
-				StealthWardsPlaced                       int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount               int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight          int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                    int     `json:"takedownOnFirstTurret"`
-				Takedowns                                int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage      int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn         int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                   int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                        int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                 int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                           int     `json:"teamBaronKills"`
-				TeamDamagePercentage                     float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                     int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                      int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                  int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                        int     `json:"turretPlatesTaken"`
-				TurretTakedowns                          int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald               int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount             int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                            int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent         float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                     float64 `json:"visionScorePerMinute"`
-				WardTakedowns                            int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                   int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                             int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges3 struct {
-				One2AssistStreakCount                    int     `json:"12AssistStreakCount"`
-				AbilityUses                              int     `json:"abilityUses"`
-				AcesBefore15Minutes                      int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                 int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                           int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount           int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                               int     `json:"bountyGold"`
-				BuffsStolen                              int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime               int     `json:"completeSupportQuestInTime"`
-				ControlWardsPlaced                       int     `json:"controlWardsPlaced"`
-				DamagePerMinute                          float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage              float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                     int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                      int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow               int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                               int     `json:"doubleAces"`
-				DragonTakedowns                          int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage         int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                float64 `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul         int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                    int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations             int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                  int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler         int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn   int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                        int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite            int     `json:"epicMonsterStolenWithoutSmite"`
-				FlawlessAces                             int     `json:"flawlessAces"`
-				FullTeamTakedown                         int     `json:"fullTeamTakedown"`
-				GameLength                               float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                            float64 `json:"goldPerMinute"`
-				HadOpenNexus                             int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                         int     `json:"initialBuffCount"`
-				InitialCrabCount                         int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                  int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster   int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall        int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                      int     `json:"kda"`
-				KillAfterHiddenWithAlly                  int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                        float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived    int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                     int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner      int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack          int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                      int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster             int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                  int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage              int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                           int     `json:"legendaryCount"`
-				LostAnInhibitor                          int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent             int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                           int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                 int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent              int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                        int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount               int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                               int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash           int     `json:"multikillsAfterAggressiveFlash"`
-				OuterTurretExecutesBefore10Minutes       int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                         int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                     int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                  int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                              int     `json:"perfectGame"`
-				PickKillWithAlly                         int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                int     `json:"playedChampSelectPosition"`
-				PoroExplosions                           int     `json:"poroExplosions"`
-				QuickCleanse                             int     `json:"quickCleanse"`
-				QuickFirstTurret                         int     `json:"quickFirstTurret"`
-				QuickSoloKills                           int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                      int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                        int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                         int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                         int     `json:"skillshotsDodged"`
-				SkillshotsHit                            int     `json:"skillshotsHit"`
-				SnowballsHit                             int     `json:"snowballsHit"`
-				SoloBaronKills                           int     `json:"soloBaronKills"`
-				SoloKills                                int     `json:"soloKills"`
-				StealthWardsPlaced                       int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount               int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight          int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                    int     `json:"takedownOnFirstTurret"`
-				Takedowns                                int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage      int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn         int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                   int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                        int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                 int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                           int     `json:"teamBaronKills"`
-				TeamDamagePercentage                     float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                     int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                      int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                  int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                        int     `json:"turretPlatesTaken"`
-				TurretTakedowns                          int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald               int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount             int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                            int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent         float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                     float64 `json:"visionScorePerMinute"`
-				WardTakedowns                            int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                   int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                             int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges4 struct {
-				One2AssistStreakCount                     int     `json:"12AssistStreakCount"`
-				AbilityUses                               int     `json:"abilityUses"`
-				AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                  int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                            int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                                int     `json:"bountyGold"`
-				BuffsStolen                               int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
-				ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
-				ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
-				DamagePerMinute                           float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                                int     `json:"doubleAces"`
-				DragonTakedowns                           int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage          int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                 int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                   int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
-				FirstTurretKilled                         float64 `json:"firstTurretKilled"`
-				FlawlessAces                              int     `json:"flawlessAces"`
-				FullTeamTakedown                          int     `json:"fullTeamTakedown"`
-				GameLength                                float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                             float64 `json:"goldPerMinute"`
-				HadOpenNexus                              int     `json:"hadOpenNexus"`
-				HighestChampionDamage                     int     `json:"highestChampionDamage"`
-				ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                          int     `json:"initialBuffCount"`
-				InitialCrabCount                          int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                   int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                       int     `json:"kda"`
-				KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                         float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage               int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                            int     `json:"legendaryCount"`
-				LostAnInhibitor                           int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent              int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                            int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent               int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                                int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                            int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                          int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                               int     `json:"perfectGame"`
-				PickKillWithAlly                          int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
-				PoroExplosions                            int     `json:"poroExplosions"`
-				QuickCleanse                              int     `json:"quickCleanse"`
-				QuickFirstTurret                          int     `json:"quickFirstTurret"`
-				QuickSoloKills                            int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                          int     `json:"skillshotsDodged"`
-				SkillshotsHit                             int     `json:"skillshotsHit"`
-				SnowballsHit                              int     `json:"snowballsHit"`
-				SoloBaronKills                            int     `json:"soloBaronKills"`
-				SoloKills                                 int     `json:"soloKills"`
-				SoloTurretsLategame                       int     `json:"soloTurretsLategame"`
-				StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
-				Takedowns                                 int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                            int     `json:"teamBaronKills"`
-				TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
-				TurretTakedowns                           int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                             int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
-				WardTakedowns                             int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                              int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges5 struct {
-				One2AssistStreakCount                     int     `json:"12AssistStreakCount"`
-				AbilityUses                               int     `json:"abilityUses"`
-				AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                  int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                            int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                                int     `json:"bountyGold"`
-				BuffsStolen                               int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
-				ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
-				ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
-				DamagePerMinute                           float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                                int     `json:"doubleAces"`
-				DragonTakedowns                           int     `json:"dragonTakedowns"`
-				EarliestDragonTakedown                    float64 `json:"earliestDragonTakedown"`
-				EarlyLaningPhaseGoldExpAdvantage          int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                 int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                   int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
-				FirstTurretKilled                         float64 `json:"firstTurretKilled"`
-				FlawlessAces                              int     `json:"flawlessAces"`
-				FullTeamTakedown                          int     `json:"fullTeamTakedown"`
-				GameLength                                float64 `json:"gameLength"`
-				GoldPerMinute                             float64 `json:"goldPerMinute"`
-				HadOpenNexus                              int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                          int     `json:"initialBuffCount"`
-				InitialCrabCount                          int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                   float64 `json:"jungleCsBefore10Minutes"`
-				JunglerKillsEarlyJungle                   int     `json:"junglerKillsEarlyJungle"`
-				JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                       int     `json:"kda"`
-				KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                         float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
-				KillsOnLanersEarlyJungleAsJungler         int     `json:"killsOnLanersEarlyJungleAsJungler"`
-				KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage               int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                            int     `json:"legendaryCount"`
-				LostAnInhibitor                           int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent              float64 `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                            int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent               float64 `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                                int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                            int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                          int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                               int     `json:"perfectGame"`
-				PickKillWithAlly                          int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
-				PoroExplosions                            int     `json:"poroExplosions"`
-				QuickCleanse                              int     `json:"quickCleanse"`
-				QuickFirstTurret                          int     `json:"quickFirstTurret"`
-				QuickSoloKills                            int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                          int     `json:"skillshotsDodged"`
-				SkillshotsHit                             int     `json:"skillshotsHit"`
-				SnowballsHit                              int     `json:"snowballsHit"`
-				SoloBaronKills                            int     `json:"soloBaronKills"`
-				SoloKills                                 int     `json:"soloKills"`
-				StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
-				Takedowns                                 int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                            int     `json:"teamBaronKills"`
-				TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
-				TurretTakedowns                           int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                             int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
-				WardTakedowns                             int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                              int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges6 struct {
-				One2AssistStreakCount                     int     `json:"12AssistStreakCount"`
-				AbilityUses                               int     `json:"abilityUses"`
-				AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                  int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                            int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                                int     `json:"bountyGold"`
-				BuffsStolen                               int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
-				ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
-				ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
-				DamagePerMinute                           float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                                int     `json:"doubleAces"`
-				DragonTakedowns                           int     `json:"dragonTakedowns"`
-				EarlyLaningPhaseGoldExpAdvantage          int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                 int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                   int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
-				FirstTurretKilled                         float64 `json:"firstTurretKilled"`
-				FlawlessAces                              int     `json:"flawlessAces"`
-				FullTeamTakedown                          int     `json:"fullTeamTakedown"`
-				GameLength                                float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                             float64 `json:"goldPerMinute"`
-				HadOpenNexus                              int     `json:"hadOpenNexus"`
-				HighestCrowdControlScore                  int     `json:"highestCrowdControlScore"`
-				ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                          int     `json:"initialBuffCount"`
-				InitialCrabCount                          int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                   int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                       float64 `json:"kda"`
-				KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                         float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillingSprees                             int     `json:"killingSprees"`
-				KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage               int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                            int     `json:"legendaryCount"`
-				LostAnInhibitor                           int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent              int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                            int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent               int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                                int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                            int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                          int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                               int     `json:"perfectGame"`
-				PickKillWithAlly                          int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
-				PoroExplosions                            int     `json:"poroExplosions"`
-				QuickCleanse                              int     `json:"quickCleanse"`
-				QuickFirstTurret                          int     `json:"quickFirstTurret"`
-				QuickSoloKills                            int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                          int     `json:"skillshotsDodged"`
-				SkillshotsHit                             int     `json:"skillshotsHit"`
-				SnowballsHit                              int     `json:"snowballsHit"`
-				SoloBaronKills                            int     `json:"soloBaronKills"`
-				SoloKills                                 int     `json:"soloKills"`
-				SoloTurretsLategame                       int     `json:"soloTurretsLategame"`
-				StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
-				Takedowns                                 int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                            int     `json:"teamBaronKills"`
-				TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
-				TurretTakedowns                           int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                             int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
-				WardTakedowns                             int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                              int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges7 struct {
-				One2AssistStreakCount                     int     `json:"12AssistStreakCount"`
-				AbilityUses                               int     `json:"abilityUses"`
-				AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                  int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                            int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                                int     `json:"bountyGold"`
-				BuffsStolen                               int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
-				ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
-				ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
-				DamagePerMinute                           float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                                int     `json:"doubleAces"`
-				DragonTakedowns                           int     `json:"dragonTakedowns"`
-				EarliestDragonTakedown                    float64 `json:"earliestDragonTakedown"`
-				EarlyLaningPhaseGoldExpAdvantage          int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                 int     `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                   int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
-				FirstTurretKilled                         float64 `json:"firstTurretKilled"`
-				FlawlessAces                              int     `json:"flawlessAces"`
-				FullTeamTakedown                          int     `json:"fullTeamTakedown"`
-				GameLength                                float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                             float64 `json:"goldPerMinute"`
-				HadOpenNexus                              int     `json:"hadOpenNexus"`
-				ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                          int     `json:"initialBuffCount"`
-				InitialCrabCount                          int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                   int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                       int     `json:"kda"`
-				KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                         float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillingSprees                             int     `json:"killingSprees"`
-				KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage               int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                            int     `json:"legendaryCount"`
-				LostAnInhibitor                           int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent              int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                            int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent               int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                                int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
-				MythicItemUsed                            int     `json:"mythicItemUsed"`
-				OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                          int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                               int     `json:"perfectGame"`
-				PickKillWithAlly                          int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
-				PoroExplosions                            int     `json:"poroExplosions"`
-				QuickCleanse                              int     `json:"quickCleanse"`
-				QuickFirstTurret                          int     `json:"quickFirstTurret"`
-				QuickSoloKills                            int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                          int     `json:"skillshotsDodged"`
-				SkillshotsHit                             int     `json:"skillshotsHit"`
-				SnowballsHit                              int     `json:"snowballsHit"`
-				SoloBaronKills                            int     `json:"soloBaronKills"`
-				SoloKills                                 int     `json:"soloKills"`
-				StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
-				Takedowns                                 int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                            int     `json:"teamBaronKills"`
-				TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
-				TurretTakedowns                           int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                             int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
-				WardTakedowns                             int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                              int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-			Challenges8 struct {
-				One2AssistStreakCount                     int     `json:"12AssistStreakCount"`
-				AbilityUses                               int     `json:"abilityUses"`
-				AcesBefore15Minutes                       int     `json:"acesBefore15Minutes"`
-				AlliedJungleMonsterKills                  int     `json:"alliedJungleMonsterKills"`
-				BaronTakedowns                            int     `json:"baronTakedowns"`
-				BlastConeOppositeOpponentCount            int     `json:"blastConeOppositeOpponentCount"`
-				BountyGold                                int     `json:"bountyGold"`
-				BuffsStolen                               int     `json:"buffsStolen"`
-				CompleteSupportQuestInTime                int     `json:"completeSupportQuestInTime"`
-				ControlWardTimeCoverageInRiverOrEnemyHalf float64 `json:"controlWardTimeCoverageInRiverOrEnemyHalf"`
-				ControlWardsPlaced                        int     `json:"controlWardsPlaced"`
-				DamagePerMinute                           float64 `json:"damagePerMinute"`
-				DamageTakenOnTeamPercentage               float64 `json:"damageTakenOnTeamPercentage"`
-				DancedWithRiftHerald                      int     `json:"dancedWithRiftHerald"`
-				DeathsByEnemyChamps                       int     `json:"deathsByEnemyChamps"`
-				DodgeSkillShotsSmallWindow                int     `json:"dodgeSkillShotsSmallWindow"`
-				DoubleAces                                int     `json:"doubleAces"`
-				DragonTakedowns                           int     `json:"dragonTakedowns"`
-				EarliestDragonTakedown                    float64 `json:"earliestDragonTakedown"`
-				EarlyLaningPhaseGoldExpAdvantage          int     `json:"earlyLaningPhaseGoldExpAdvantage"`
-				EffectiveHealAndShielding                 float64 `json:"effectiveHealAndShielding"`
-				ElderDragonKillsWithOpposingSoul          int     `json:"elderDragonKillsWithOpposingSoul"`
-				ElderDragonMultikills                     int     `json:"elderDragonMultikills"`
-				EnemyChampionImmobilizations              int     `json:"enemyChampionImmobilizations"`
-				EnemyJungleMonsterKills                   int     `json:"enemyJungleMonsterKills"`
-				EpicMonsterKillsNearEnemyJungler          int     `json:"epicMonsterKillsNearEnemyJungler"`
-				EpicMonsterKillsWithin30SecondsOfSpawn    int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
-				EpicMonsterSteals                         int     `json:"epicMonsterSteals"`
-				EpicMonsterStolenWithoutSmite             int     `json:"epicMonsterStolenWithoutSmite"`
-				FirstTurretKilled                         float64 `json:"firstTurretKilled"`
-				FlawlessAces                              int     `json:"flawlessAces"`
-				FullTeamTakedown                          int     `json:"fullTeamTakedown"`
-				GameLength                                float64 `json:"gameLength"`
-				GetTakedownsInAllLanesEarlyJungleAsLaner  int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
-				GoldPerMinute                             float64 `json:"goldPerMinute"`
-				HadOpenNexus                              int     `json:"hadOpenNexus"`
-				HighestWardKills                          int     `json:"highestWardKills"`
-				ImmobilizeAndKillWithAlly                 int     `json:"immobilizeAndKillWithAlly"`
-				InitialBuffCount                          int     `json:"initialBuffCount"`
-				InitialCrabCount                          int     `json:"initialCrabCount"`
-				JungleCsBefore10Minutes                   int     `json:"jungleCsBefore10Minutes"`
-				JunglerTakedownsNearDamagedEpicMonster    int     `json:"junglerTakedownsNearDamagedEpicMonster"`
-				KTurretsDestroyedBeforePlatesFall         int     `json:"kTurretsDestroyedBeforePlatesFall"`
-				Kda                                       int     `json:"kda"`
-				KillAfterHiddenWithAlly                   int     `json:"killAfterHiddenWithAlly"`
-				KillParticipation                         float64 `json:"killParticipation"`
-				KilledChampTookFullTeamDamageSurvived     int     `json:"killedChampTookFullTeamDamageSurvived"`
-				KillsNearEnemyTurret                      int     `json:"killsNearEnemyTurret"`
-				KillsOnOtherLanesEarlyJungleAsLaner       int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
-				KillsOnRecentlyHealedByAramPack           int     `json:"killsOnRecentlyHealedByAramPack"`
-				KillsUnderOwnTurret                       int     `json:"killsUnderOwnTurret"`
-				KillsWithHelpFromEpicMonster              int     `json:"killsWithHelpFromEpicMonster"`
-				KnockEnemyIntoTeamAndKill                 int     `json:"knockEnemyIntoTeamAndKill"`
-				LandSkillShotsEarlyGame                   int     `json:"landSkillShotsEarlyGame"`
-				LaneMinionsFirst10Minutes                 int     `json:"laneMinionsFirst10Minutes"`
-				LaningPhaseGoldExpAdvantage               int     `json:"laningPhaseGoldExpAdvantage"`
-				LegendaryCount                            int     `json:"legendaryCount"`
-				LostAnInhibitor                           int     `json:"lostAnInhibitor"`
-				MaxCsAdvantageOnLaneOpponent              int     `json:"maxCsAdvantageOnLaneOpponent"`
-				MaxKillDeficit                            int     `json:"maxKillDeficit"`
-				MaxLevelLeadLaneOpponent                  int     `json:"maxLevelLeadLaneOpponent"`
-				MoreEnemyJungleThanOpponent               int     `json:"moreEnemyJungleThanOpponent"`
-				MultiKillOneSpell                         int     `json:"multiKillOneSpell"`
-				MultiTurretRiftHeraldCount                int     `json:"multiTurretRiftHeraldCount"`
-				Multikills                                int     `json:"multikills"`
-				MultikillsAfterAggressiveFlash            int     `json:"multikillsAfterAggressiveFlash"`
-				OuterTurretExecutesBefore10Minutes        int     `json:"outerTurretExecutesBefore10Minutes"`
-				OutnumberedKills                          int     `json:"outnumberedKills"`
-				OutnumberedNexusKill                      int     `json:"outnumberedNexusKill"`
-				PerfectDragonSoulsTaken                   int     `json:"perfectDragonSoulsTaken"`
-				PerfectGame                               int     `json:"perfectGame"`
-				PickKillWithAlly                          int     `json:"pickKillWithAlly"`
-				PlayedChampSelectPosition                 int     `json:"playedChampSelectPosition"`
-				PoroExplosions                            int     `json:"poroExplosions"`
-				QuickCleanse                              int     `json:"quickCleanse"`
-				QuickFirstTurret                          int     `json:"quickFirstTurret"`
-				QuickSoloKills                            int     `json:"quickSoloKills"`
-				RiftHeraldTakedowns                       int     `json:"riftHeraldTakedowns"`
-				SaveAllyFromDeath                         int     `json:"saveAllyFromDeath"`
-				ScuttleCrabKills                          int     `json:"scuttleCrabKills"`
-				SkillshotsDodged                          int     `json:"skillshotsDodged"`
-				SkillshotsHit                             int     `json:"skillshotsHit"`
-				SnowballsHit                              int     `json:"snowballsHit"`
-				SoloBaronKills                            int     `json:"soloBaronKills"`
-				SoloKills                                 int     `json:"soloKills"`
-				StealthWardsPlaced                        int     `json:"stealthWardsPlaced"`
-				SurvivedSingleDigitHpCount                int     `json:"survivedSingleDigitHpCount"`
-				SurvivedThreeImmobilizesInFight           int     `json:"survivedThreeImmobilizesInFight"`
-				TakedownOnFirstTurret                     int     `json:"takedownOnFirstTurret"`
-				Takedowns                                 int     `json:"takedowns"`
-				TakedownsAfterGainingLevelAdvantage       int     `json:"takedownsAfterGainingLevelAdvantage"`
-				TakedownsBeforeJungleMinionSpawn          int     `json:"takedownsBeforeJungleMinionSpawn"`
-				TakedownsFirstXMinutes                    int     `json:"takedownsFirstXMinutes"`
-				TakedownsInAlcove                         int     `json:"takedownsInAlcove"`
-				TakedownsInEnemyFountain                  int     `json:"takedownsInEnemyFountain"`
-				TeamBaronKills                            int     `json:"teamBaronKills"`
-				TeamDamagePercentage                      float64 `json:"teamDamagePercentage"`
-				TeamElderDragonKills                      int     `json:"teamElderDragonKills"`
-				TeamRiftHeraldKills                       int     `json:"teamRiftHeraldKills"`
-				ThreeWardsOneSweeperCount                 int     `json:"threeWardsOneSweeperCount"`
-				TookLargeDamageSurvived                   int     `json:"tookLargeDamageSurvived"`
-				TurretPlatesTaken                         int     `json:"turretPlatesTaken"`
-				TurretTakedowns                           int     `json:"turretTakedowns"`
-				TurretsTakenWithRiftHerald                int     `json:"turretsTakenWithRiftHerald"`
-				TwentyMinionsIn3SecondsCount              int     `json:"twentyMinionsIn3SecondsCount"`
-				UnseenRecalls                             int     `json:"unseenRecalls"`
-				VisionScoreAdvantageLaneOpponent          float64 `json:"visionScoreAdvantageLaneOpponent"`
-				VisionScorePerMinute                      float64 `json:"visionScorePerMinute"`
-				WardTakedowns                             int     `json:"wardTakedowns"`
-				WardTakedownsBefore20M                    int     `json:"wardTakedownsBefore20M"`
-				WardsGuarded                              int     `json:"wardsGuarded"`
-			} `json:"challenges,omitempty"`
-		} `json:"participants"`
-		PlatformID string `json:"platformId"`
-		QueueID    int    `json:"queueId"`
-		Teams      []struct {
-			Bans []struct {
-				ChampionID int `json:"championId"`
-				PickTurn   int `json:"pickTurn"`
-			} `json:"bans"`
-			Objectives struct {
-				Baron struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"baron"`
-				Champion struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"champion"`
-				Dragon struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"dragon"`
-				Inhibitor struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"inhibitor"`
-				RiftHerald struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"riftHerald"`
-				Tower struct {
-					First bool `json:"first"`
-					Kills int  `json:"kills"`
-				} `json:"tower"`
-			} `json:"objectives"`
-			TeamID int  `json:"teamId"`
-			Win    bool `json:"win"`
-		} `json:"teams"`
-		TournamentCode string `json:"tournamentCode"`
+		GameCreation       int64         `json:"gameCreation"`
+		GameDuration       int           `json:"gameDuration"`
+		GameEndTimestamp   int64         `json:"gameEndTimestamp"`
+		GameID             int64         `json:"gameId"`
+		GameMode           string        `json:"gameMode"`
+		GameName           string        `json:"gameName"`
+		GameStartTimestamp int64         `json:"gameStartTimestamp"`
+		GameType           string        `json:"gameType"`
+		GameVersion        string        `json:"gameVersion"`
+		MapID              int           `json:"mapId"`
+		Participants       []Participant `json:"participants"`
+		PlatformID         string        `json:"platformId"`
+		QueueID            int           `json:"queueId"`
+		Teams              []Team        `json:"teams"`
+		TournamentCode     string        `json:"tournamentCode"`
 	} `json:"info"`
 }
+
+type Participant struct {
+	AllInPings    int `json:"allInPings"`
+	AssistMePings int `json:"assistMePings"`
+	Assists       int `json:"assists"`
+	BaitPings     int `json:"baitPings"`
+	BaronKills    int `json:"baronKills"`
+	BasicPings    int `json:"basicPings"`
+	BountyLevel   int `json:"bountyLevel"`
+	Challenges    struct {
+		One2AssistStreakCount                    int     `json:"12AssistStreakCount"`
+		AbilityUses                              int     `json:"abilityUses"`
+		AcesBefore15Minutes                      int     `json:"acesBefore15Minutes"`
+		AlliedJungleMonsterKills                 int     `json:"alliedJungleMonsterKills"`
+		BaronTakedowns                           int     `json:"baronTakedowns"`
+		BlastConeOppositeOpponentCount           int     `json:"blastConeOppositeOpponentCount"`
+		BountyGold                               int     `json:"bountyGold"`
+		BuffsStolen                              int     `json:"buffsStolen"`
+		CompleteSupportQuestInTime               int     `json:"completeSupportQuestInTime"`
+		ControlWardsPlaced                       int     `json:"controlWardsPlaced"`
+		DamagePerMinute                          float64 `json:"damagePerMinute"`
+		DamageTakenOnTeamPercentage              float64 `json:"damageTakenOnTeamPercentage"`
+		DancedWithRiftHerald                     int     `json:"dancedWithRiftHerald"`
+		DeathsByEnemyChamps                      int     `json:"deathsByEnemyChamps"`
+		DodgeSkillShotsSmallWindow               int     `json:"dodgeSkillShotsSmallWindow"`
+		DoubleAces                               int     `json:"doubleAces"`
+		DragonTakedowns                          int     `json:"dragonTakedowns"`
+		EarlyLaningPhaseGoldExpAdvantage         int     `json:"earlyLaningPhaseGoldExpAdvantage"`
+		EffectiveHealAndShielding                int     `json:"effectiveHealAndShielding"`
+		ElderDragonKillsWithOpposingSoul         int     `json:"elderDragonKillsWithOpposingSoul"`
+		ElderDragonMultikills                    int     `json:"elderDragonMultikills"`
+		EnemyChampionImmobilizations             int     `json:"enemyChampionImmobilizations"`
+		EnemyJungleMonsterKills                  int     `json:"enemyJungleMonsterKills"`
+		EpicMonsterKillsNearEnemyJungler         int     `json:"epicMonsterKillsNearEnemyJungler"`
+		EpicMonsterKillsWithin30SecondsOfSpawn   int     `json:"epicMonsterKillsWithin30SecondsOfSpawn"`
+		EpicMonsterSteals                        int     `json:"epicMonsterSteals"`
+		EpicMonsterStolenWithoutSmite            int     `json:"epicMonsterStolenWithoutSmite"`
+		FlawlessAces                             int     `json:"flawlessAces"`
+		FullTeamTakedown                         int     `json:"fullTeamTakedown"`
+		GameLength                               float64 `json:"gameLength"`
+		GetTakedownsInAllLanesEarlyJungleAsLaner int     `json:"getTakedownsInAllLanesEarlyJungleAsLaner"`
+		GoldPerMinute                            float64 `json:"goldPerMinute"`
+		HadOpenNexus                             int     `json:"hadOpenNexus"`
+		ImmobilizeAndKillWithAlly                int     `json:"immobilizeAndKillWithAlly"`
+		InitialBuffCount                         int     `json:"initialBuffCount"`
+		InitialCrabCount                         int     `json:"initialCrabCount"`
+		JungleCsBefore10Minutes                  int     `json:"jungleCsBefore10Minutes"`
+		JunglerTakedownsNearDamagedEpicMonster   int     `json:"junglerTakedownsNearDamagedEpicMonster"`
+		KTurretsDestroyedBeforePlatesFall        int     `json:"kTurretsDestroyedBeforePlatesFall"`
+		Kda                                      float64 `json:"kda"`
+		KillAfterHiddenWithAlly                  int     `json:"killAfterHiddenWithAlly"`
+		KillParticipation                        float64 `json:"killParticipation"`
+		KilledChampTookFullTeamDamageSurvived    int     `json:"killedChampTookFullTeamDamageSurvived"`
+		KillsNearEnemyTurret                     int     `json:"killsNearEnemyTurret"`
+		KillsOnOtherLanesEarlyJungleAsLaner      int     `json:"killsOnOtherLanesEarlyJungleAsLaner"`
+		KillsOnRecentlyHealedByAramPack          int     `json:"killsOnRecentlyHealedByAramPack"`
+		KillsUnderOwnTurret                      int     `json:"killsUnderOwnTurret"`
+		KillsWithHelpFromEpicMonster             int     `json:"killsWithHelpFromEpicMonster"`
+		KnockEnemyIntoTeamAndKill                int     `json:"knockEnemyIntoTeamAndKill"`
+		LandSkillShotsEarlyGame                  int     `json:"landSkillShotsEarlyGame"`
+		LaneMinionsFirst10Minutes                int     `json:"laneMinionsFirst10Minutes"`
+		LaningPhaseGoldExpAdvantage              int     `json:"laningPhaseGoldExpAdvantage"`
+		LegendaryCount                           int     `json:"legendaryCount"`
+		LostAnInhibitor                          int     `json:"lostAnInhibitor"`
+		MaxCsAdvantageOnLaneOpponent             int     `json:"maxCsAdvantageOnLaneOpponent"`
+		MaxKillDeficit                           int     `json:"maxKillDeficit"`
+		MaxLevelLeadLaneOpponent                 int     `json:"maxLevelLeadLaneOpponent"`
+		MoreEnemyJungleThanOpponent              int     `json:"moreEnemyJungleThanOpponent"`
+		MultiKillOneSpell                        int     `json:"multiKillOneSpell"`
+		MultiTurretRiftHeraldCount               int     `json:"multiTurretRiftHeraldCount"`
+		Multikills                               int     `json:"multikills"`
+		MultikillsAfterAggressiveFlash           int     `json:"multikillsAfterAggressiveFlash"`
+		MythicItemUsed                           int     `json:"mythicItemUsed"`
+		OuterTurretExecutesBefore10Minutes       int     `json:"outerTurretExecutesBefore10Minutes"`
+		OutnumberedKills                         int     `json:"outnumberedKills"`
+		OutnumberedNexusKill                     int     `json:"outnumberedNexusKill"`
+		PerfectDragonSoulsTaken                  int     `json:"perfectDragonSoulsTaken"`
+		PerfectGame                              int     `json:"perfectGame"`
+		PickKillWithAlly                         int     `json:"pickKillWithAlly"`
+		PlayedChampSelectPosition                int     `json:"playedChampSelectPosition"`
+		PoroExplosions                           int     `json:"poroExplosions"`
+		QuickCleanse                             int     `json:"quickCleanse"`
+		QuickFirstTurret                         int     `json:"quickFirstTurret"`
+		QuickSoloKills                           int     `json:"quickSoloKills"`
+		RiftHeraldTakedowns                      int     `json:"riftHeraldTakedowns"`
+		SaveAllyFromDeath                        int     `json:"saveAllyFromDeath"`
+		ScuttleCrabKills                         int     `json:"scuttleCrabKills"`
+		SkillshotsDodged                         int     `json:"skillshotsDodged"`
+		SkillshotsHit                            int     `json:"skillshotsHit"`
+		SnowballsHit                             int     `json:"snowballsHit"`
+		SoloBaronKills                           int     `json:"soloBaronKills"`
+		SoloKills                                int     `json:"soloKills"`
+		StealthWardsPlaced                       int     `json:"stealthWardsPlaced"`
+		SurvivedSingleDigitHpCount               int     `json:"survivedSingleDigitHpCount"`
+		SurvivedThreeImmobilizesInFight          int     `json:"survivedThreeImmobilizesInFight"`
+		TakedownOnFirstTurret                    int     `json:"takedownOnFirstTurret"`
+		Takedowns                                int     `json:"takedowns"`
+		TakedownsAfterGainingLevelAdvantage      int     `json:"takedownsAfterGainingLevelAdvantage"`
+		TakedownsBeforeJungleMinionSpawn         int     `json:"takedownsBeforeJungleMinionSpawn"`
+		TakedownsFirstXMinutes                   int     `json:"takedownsFirstXMinutes"`
+		TakedownsInAlcove                        int     `json:"takedownsInAlcove"`
+		TakedownsInEnemyFountain                 int     `json:"takedownsInEnemyFountain"`
+		TeamBaronKills                           int     `json:"teamBaronKills"`
+		TeamDamagePercentage                     float64 `json:"teamDamagePercentage"`
+		TeamElderDragonKills                     int     `json:"teamElderDragonKills"`
+		TeamRiftHeraldKills                      int     `json:"teamRiftHeraldKills"`
+		ThreeWardsOneSweeperCount                int     `json:"threeWardsOneSweeperCount"`
+		TookLargeDamageSurvived                  int     `json:"tookLargeDamageSurvived"`
+		TurretPlatesTaken                        int     `json:"turretPlatesTaken"`
+		TurretTakedowns                          int     `json:"turretTakedowns"`
+		TurretsTakenWithRiftHerald               int     `json:"turretsTakenWithRiftHerald"`
+		TwentyMinionsIn3SecondsCount             int     `json:"twentyMinionsIn3SecondsCount"`
+		UnseenRecalls                            int     `json:"unseenRecalls"`
+		VisionScoreAdvantageLaneOpponent         float64 `json:"visionScoreAdvantageLaneOpponent"`
+		VisionScorePerMinute                     float64 `json:"visionScorePerMinute"`
+		WardTakedowns                            int     `json:"wardTakedowns"`
+		WardTakedownsBefore20M                   int     `json:"wardTakedownsBefore20M"`
+		WardsGuarded                             int     `json:"wardsGuarded"`
+	} `json:"challenges,omitempty"`
+	ChampExperience             int    `json:"champExperience"`
+	ChampLevel                  int    `json:"champLevel"`
+	ChampionID                  int    `json:"championId"`
+	ChampionName                string `json:"championName"`
+	ChampionTransform           int    `json:"championTransform"`
+	CommandPings                int    `json:"commandPings"`
+	ConsumablesPurchased        int    `json:"consumablesPurchased"`
+	DamageDealtToBuildings      int    `json:"damageDealtToBuildings"`
+	DamageDealtToObjectives     int    `json:"damageDealtToObjectives"`
+	DamageDealtToTurrets        int    `json:"damageDealtToTurrets"`
+	DamageSelfMitigated         int    `json:"damageSelfMitigated"`
+	DangerPings                 int    `json:"dangerPings"`
+	Deaths                      int    `json:"deaths"`
+	DetectorWardsPlaced         int    `json:"detectorWardsPlaced"`
+	DoubleKills                 int    `json:"doubleKills"`
+	DragonKills                 int    `json:"dragonKills"`
+	EligibleForProgression      bool   `json:"eligibleForProgression"`
+	EnemyMissingPings           int    `json:"enemyMissingPings"`
+	EnemyVisionPings            int    `json:"enemyVisionPings"`
+	FirstBloodAssist            bool   `json:"firstBloodAssist"`
+	FirstBloodKill              bool   `json:"firstBloodKill"`
+	FirstTowerAssist            bool   `json:"firstTowerAssist"`
+	FirstTowerKill              bool   `json:"firstTowerKill"`
+	GameEndedInEarlySurrender   bool   `json:"gameEndedInEarlySurrender"`
+	GameEndedInSurrender        bool   `json:"gameEndedInSurrender"`
+	GetBackPings                int    `json:"getBackPings"`
+	GoldEarned                  int    `json:"goldEarned"`
+	GoldSpent                   int    `json:"goldSpent"`
+	HoldPings                   int    `json:"holdPings"`
+	IndividualPosition          string `json:"individualPosition"`
+	InhibitorKills              int    `json:"inhibitorKills"`
+	InhibitorTakedowns          int    `json:"inhibitorTakedowns"`
+	InhibitorsLost              int    `json:"inhibitorsLost"`
+	Item0                       int    `json:"item0"`
+	Item1                       int    `json:"item1"`
+	Item2                       int    `json:"item2"`
+	Item3                       int    `json:"item3"`
+	Item4                       int    `json:"item4"`
+	Item5                       int    `json:"item5"`
+	Item6                       int    `json:"item6"`
+	ItemsPurchased              int    `json:"itemsPurchased"`
+	KillingSprees               int    `json:"killingSprees"`
+	Kills                       int    `json:"kills"`
+	Lane                        string `json:"lane"`
+	LargestCriticalStrike       int    `json:"largestCriticalStrike"`
+	LargestKillingSpree         int    `json:"largestKillingSpree"`
+	LargestMultiKill            int    `json:"largestMultiKill"`
+	LongestTimeSpentLiving      int    `json:"longestTimeSpentLiving"`
+	MagicDamageDealt            int    `json:"magicDamageDealt"`
+	MagicDamageDealtToChampions int    `json:"magicDamageDealtToChampions"`
+	MagicDamageTaken            int    `json:"magicDamageTaken"`
+	NeedVisionPings             int    `json:"needVisionPings"`
+	NeutralMinionsKilled        int    `json:"neutralMinionsKilled"`
+	NexusKills                  int    `json:"nexusKills"`
+	NexusLost                   int    `json:"nexusLost"`
+	NexusTakedowns              int    `json:"nexusTakedowns"`
+	ObjectivesStolen            int    `json:"objectivesStolen"`
+	ObjectivesStolenAssists     int    `json:"objectivesStolenAssists"`
+	OnMyWayPings                int    `json:"onMyWayPings"`
+	ParticipantID               int    `json:"participantId"`
+	PentaKills                  int    `json:"pentaKills"`
+	Perks                       struct {
+		StatPerks struct {
+			Defense int `json:"defense"`
+			Flex    int `json:"flex"`
+			Offense int `json:"offense"`
+		} `json:"statPerks"`
+		Styles []struct {
+			Description string `json:"description"`
+			Selections  []struct {
+				Perk int `json:"perk"`
+				Var1 int `json:"var1"`
+				Var2 int `json:"var2"`
+				Var3 int `json:"var3"`
+			} `json:"selections"`
+			Style int `json:"style"`
+		} `json:"styles"`
+	} `json:"perks"`
+	PhysicalDamageDealt            int    `json:"physicalDamageDealt"`
+	PhysicalDamageDealtToChampions int    `json:"physicalDamageDealtToChampions"`
+	PhysicalDamageTaken            int    `json:"physicalDamageTaken"`
+	ProfileIcon                    int    `json:"profileIcon"`
+	PushPings                      int    `json:"pushPings"`
+	Puuid                          string `json:"puuid"`
+	QuadraKills                    int    `json:"quadraKills"`
+	RiotIDGameName                 string `json:"riotIdGameName"`
+	RiotIDName                     string `json:"riotIdName"`
+	RiotIDTagline                  string `json:"riotIdTagline"`
+	Role                           string `json:"role"`
+	SightWardsBoughtInGame         int    `json:"sightWardsBoughtInGame"`
+	Spell1Casts                    int    `json:"spell1Casts"`
+	Spell2Casts                    int    `json:"spell2Casts"`
+	Spell3Casts                    int    `json:"spell3Casts"`
+	Spell4Casts                    int    `json:"spell4Casts"`
+	Summoner1Casts                 int    `json:"summoner1Casts"`
+	Summoner1ID                    int    `json:"summoner1Id"`
+	Summoner2Casts                 int    `json:"summoner2Casts"`
+	Summoner2ID                    int    `json:"summoner2Id"`
+	SummonerID                     string `json:"summonerId"`
+	SummonerLevel                  int    `json:"summonerLevel"`
+	SummonerName                   string `json:"summonerName"`
+	TeamEarlySurrendered           bool   `json:"teamEarlySurrendered"`
+	TeamID                         int    `json:"teamId"`
+	TeamPosition                   string `json:"teamPosition"`
+	TimeCCingOthers                int    `json:"timeCCingOthers"`
+	TimePlayed                     int    `json:"timePlayed"`
+	TotalDamageDealt               int    `json:"totalDamageDealt"`
+	TotalDamageDealtToChampions    int    `json:"totalDamageDealtToChampions"`
+	TotalDamageShieldedOnTeammates int    `json:"totalDamageShieldedOnTeammates"`
+	TotalDamageTaken               int    `json:"totalDamageTaken"`
+	TotalHeal                      int    `json:"totalHeal"`
+	TotalHealsOnTeammates          int    `json:"totalHealsOnTeammates"`
+	TotalMinionsKilled             int    `json:"totalMinionsKilled"`
+	TotalTimeCCDealt               int    `json:"totalTimeCCDealt"`
+	TotalTimeSpentDead             int    `json:"totalTimeSpentDead"`
+	TotalUnitsHealed               int    `json:"totalUnitsHealed"`
+	TripleKills                    int    `json:"tripleKills"`
+	TrueDamageDealt                int    `json:"trueDamageDealt"`
+	TrueDamageDealtToChampions     int    `json:"trueDamageDealtToChampions"`
+	TrueDamageTaken                int    `json:"trueDamageTaken"`
+	TurretKills                    int    `json:"turretKills"`
+	TurretTakedowns                int    `json:"turretTakedowns"`
+	TurretsLost                    int    `json:"turretsLost"`
+	UnrealKills                    int    `json:"unrealKills"`
+	VisionClearedPings             int    `json:"visionClearedPings"`
+	VisionScore                    int    `json:"visionScore"`
+	VisionWardsBoughtInGame        int    `json:"visionWardsBoughtInGame"`
+	WardsKilled                    int    `json:"wardsKilled"`
+	WardsPlaced                    int    `json:"wardsPlaced"`
+	Win                            bool   `json:"win"`
+}
+
+// DisplayName returns the Riot ID game name, summoner names are empty on
+// matches played after the Riot ID migration.
+func (p Participant) DisplayName() string {
+	if p.RiotIDGameName != "" {
+		return p.RiotIDGameName
+	}
+
+	return p.SummonerName
+}
+
+// TotalPings adds up every kind of ping the player sent.
+func (p Participant) TotalPings() int {
+	return p.AllInPings + p.AssistMePings + p.BaitPings + p.BasicPings + p.CommandPings +
+		p.DangerPings + p.EnemyMissingPings + p.EnemyVisionPings + p.GetBackPings +
+		p.HoldPings + p.NeedVisionPings + p.OnMyWayPings + p.PushPings + p.VisionClearedPings
+}
+
+type Team struct {
+	Bans []struct {
+		ChampionID int `json:"championId"`
+		PickTurn   int `json:"pickTurn"`
+	} `json:"bans"`
+	Objectives struct {
+		Baron struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"baron"`
+		Champion struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"champion"`
+		Dragon struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"dragon"`
+		Inhibitor struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"inhibitor"`
+		RiftHerald struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"riftHerald"`
+		Tower struct {
+			First bool `json:"first"`
+			Kills int  `json:"kills"`
+		} `json:"tower"`
+	} `json:"objectives"`
+	TeamID int  `json:"teamId"`
+	Win    bool `json:"win"`
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"fmt"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// LeagueChange is the difference between the league entry of an account
+// before and after a ranked game.
+type LeagueChange struct {
+	Before  LeagueSnapshot
+	After   LeagueSnapshot
+	Games   int
+	LPDelta int
+}
+
+// Promoted is true when the account moved up a tier or division.
+func (l LeagueChange) Promoted() bool {
+	return compareRanks(l.After.Tier, l.After.Rank, l.Before.Tier, l.Before.Rank) > 0
+}
+
+// Demoted is true when the account moved down a tier or division.
+func (l LeagueChange) Demoted() bool {
+	return compareRanks(l.After.Tier, l.After.Rank, l.Before.Tier, l.Before.Rank) < 0
+}
+
+func (l LeagueChange) EnteredPromos() bool {
+	return l.Before.MiniSeries == "" && l.After.MiniSeries != ""
+}
+
+func (l LeagueChange) LeftPromos() bool {
+	return l.Before.MiniSeries != "" && l.After.MiniSeries == ""
+}
+
+func snapshotFromLeague(puuid string, league League) LeagueSnapshot {
+	return LeagueSnapshot{
+		Puuid:        puuid,
+		QueueType:    league.QueueType,
+		Tier:         league.Tier,
+		Rank:         league.Rank,
+		LeaguePoints: league.LeaguePoints,
+		Wins:         league.Wins,
+		Losses:       league.Losses,
+		MiniSeries:   league.MiniSeries.Progress,
+	}
+}
+
+func (c *LeviClient) lastLeagueSnapshot(puuid, queueType string) (LeagueSnapshot, bool) {
+	var snapshot LeagueSnapshot
+	found := c.db.Where("puuid = ? AND queue_type = ?", puuid, queueType).
+		Order("id desc").Limit(1).Find(&snapshot).RowsAffected > 0
+
+	return snapshot, found
+}
+
+// snapshotLeagues stores the current ranked entries of an account, used as
+// the starting point for the LP of its next ranked game.
+func (c *LeviClient) snapshotLeagues(acc Account) {
+	leagues, err := c.lolClient.GetLeagueBySummonerId(acc.Platform, acc.Id)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not snapshot leagues of %s: %s", acc.RiotId(), err)
+		return
+	}
+
+	for _, league := range leagues {
+		if _, ok := queueTypeNames[league.QueueType]; !ok {
+			continue
+		}
+
+		snapshot := snapshotFromLeague(acc.Puuid, league)
+		c.db.Create(&snapshot)
+	}
+}
+
+// snapshotMissingLeagues takes a first snapshot of accounts that never had
+// one, so their next ranked game already shows LP.
+func (c *LeviClient) snapshotMissingLeagues() {
+	for _, player := range c.trackedPlayers() {
+		var count int64
+		c.db.Model(&LeagueSnapshot{}).Where("puuid = ?", player.account.Puuid).Count(&count)
+
+		if count == 0 {
+			c.snapshotLeagues(player.account)
+		}
+	}
+}
+
+// How many polls a ranked game waits for league-v4 to count it, after that
+// its LP shows up merged with the next game.
+const maxLeagueRetries = 4
+
+// pendingLeague is a ranked game that league-v4 hadn't counted yet when it
+// was announced.
+type pendingLeague struct {
+	account Account
+	match   Match
+	polls   int
+}
+
+// trackLeagueChange snapshots the league entry of the queue the match was
+// played in and compares it with the previous one. It returns nil for non
+// ranked games and remakes, or when Riot hasn't updated the entry yet, in
+// which case it's checked again on the next polls.
+func (c *LeviClient) trackLeagueChange(acc Account, match Match) *LeagueChange {
+	// a newer game includes the one still pending
+	delete(c.pendingLeagues, acc.Puuid)
+
+	change, updated := c.leagueChange(acc, match)
+	if !updated {
+		c.pendingLeagues[acc.Puuid] = &pendingLeague{account: acc, match: match}
+	}
+
+	return change
+}
+
+// retryPendingLeagues checks again the games whose LP wasn't counted yet,
+// and announces it to the groups that heard about the game once it is.
+func (c *LeviClient) retryPendingLeagues() {
+	for puuid, pending := range c.pendingLeagues {
+		change, updated := c.leagueChange(pending.account, pending.match)
+
+		if !updated {
+			pending.polls++
+			if pending.polls >= maxLeagueRetries {
+				delete(c.pendingLeagues, puuid)
+			}
+			continue
+		}
+
+		delete(c.pendingLeagues, puuid)
+		if change == nil {
+			continue
+		}
+
+		acc := pending.account
+		for _, group := range c.followers(acc) {
+			sub, ok := c.subscription(group, acc)
+			if !ok || !c.shouldAnnounce(group, sub, pending.match.Info.QueueID) {
+				continue
+			}
+
+			c.SendText(group, fmt.Sprintf(
+				"Bot: LP de la ultima partida de %s en %s: %s",
+				acc.RiotId(),
+				queueTypeNames[change.After.QueueType],
+				formatLPDelta(change),
+			))
+			c.announceLeagueChange(group, acc, change)
+		}
+	}
+}
+
+// leagueChange returns the change of the match, updated is false while the
+// league entry doesn't count it yet or can't be fetched.
+func (c *LeviClient) leagueChange(acc Account, match Match) (change *LeagueChange, updated bool) {
+	queueType, ok := rankedQueueTypes[match.Info.QueueID]
+	if !ok {
+		return nil, true
+	}
+
+	// remakes don't change LP
+	for _, p := range match.Info.Participants {
+		if p.Puuid == acc.Puuid && classifyMatch(match, p) == OutcomeRemake {
+			return nil, true
+		}
+	}
+
+	leagues, err := c.lolClient.GetLeagueBySummonerId(acc.Platform, acc.Id)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not retrieve leagues of %s: %s", acc.RiotId(), err)
+		return nil, false
+	}
+
+	league, ok := findLeague(leagues, queueType)
+	if !ok {
+		// still in placements
+		return nil, true
+	}
+
+	after := snapshotFromLeague(acc.Puuid, league)
+	after.MatchId = match.Metadata.MatchID
+	before, found := c.lastLeagueSnapshot(acc.Puuid, queueType)
+
+	games := (after.Wins + after.Losses) - (before.Wins + before.Losses)
+	if found && games <= 0 {
+		return nil, false
+	}
+
+	c.db.Create(&after)
+
+	if !found {
+		return nil, true
+	}
+
+	return &LeagueChange{
+		Before: before,
+		After:  after,
+		Games:  games,
+		LPDelta: absoluteLP(after.Tier, after.Rank, after.LeaguePoints) -
+			absoluteLP(before.Tier, before.Rank, before.LeaguePoints),
+	}, true
+}
+
+// formatLPDelta returns "+18 LP" or "-21 LP (3 partidas)".
+func formatLPDelta(change *LeagueChange) string {
+	delta := fmt.Sprintf("%+d LP", change.LPDelta)
+
+	if change.Games > 1 {
+		delta += fmt.Sprintf(" (%d partidas)", change.Games)
+	}
+
+	return delta
+}
+
+// announceLeagueChange sends the promotion, demotion and promo series
+// messages of a ranked game.
+func (c *LeviClient) announceLeagueChange(group types.JID, acc Account, change *LeagueChange) {
+	queue := queueTypeNames[change.After.QueueType]
+	rank := fmt.Sprintf("%s %s", change.After.Tier, change.After.Rank)
+	if tierIndex(change.After.Tier) >= tierIndex("MASTER") {
+		rank = change.After.Tier
+	}
+
+	switch {
+	case change.Promoted():
+		msg := fmt.Sprintf("Bot: 🎉 %s ha subido a %s en %s!", acc.RiotId(), rank, queue)
+		c.SendText(group, c.withPhrase(group, msg, "promotion", acc))
+	case change.Demoted():
+		msg := fmt.Sprintf("Bot: 📉 %s ha bajado a %s en %s, que verguenza", acc.RiotId(), rank, queue)
+		c.SendText(group, c.withPhrase(group, msg, "demotion", acc))
+	case change.LeftPromos():
+		c.SendText(group, fmt.Sprintf("Bot: %s ha fallado la promo en %s, sigue en %s", acc.RiotId(), queue, rank))
+	}
+
+	if change.EnteredPromos() {
+		c.SendText(group, fmt.Sprintf(
+			"Bot: %s esta en promo en %s! %s",
+			acc.RiotId(),
+			queue,
+			formatMiniSeries(change.After.MiniSeries),
+		))
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLeagueChangeWaitsForLeagueV4(t *testing.T) {
+	// league-v4 counts the game from the third request on
+	var wins int32 = 10
+	client, _ := fakeRiot(t, func(hit int32, w http.ResponseWriter, r *http.Request) {
+		if hit == 3 {
+			atomic.StoreInt32(&wins, 11)
+		}
+		fmt.Fprintf(w, `[{"queueType": "RANKED_SOLO_5x5", "tier": "GOLD", "rank": "II", "leaguePoints": %d, "wins": %d, "losses": 10}]`,
+			40+8*(atomic.LoadInt32(&wins)-10), atomic.LoadInt32(&wins))
+	})
+
+	c := newTestClient(t)
+	c.lolClient = client
+	match := loadMatch(t, "EUW1_6612345678")
+	acc := Account{Name: "Keko", Puuid: "puuid-keko", Id: "summoner-keko", Platform: "euw1"}
+
+	c.snapshotLeagues(acc)
+
+	if change := c.trackLeagueChange(acc, match); change != nil {
+		t.Fatalf("got a change before league-v4 counted the game: %+v", change)
+	}
+	if _, pending := c.pendingLeagues[acc.Puuid]; !pending {
+		t.Fatal("the game isn't pending")
+	}
+
+	c.retryPendingLeagues()
+
+	if _, pending := c.pendingLeagues[acc.Puuid]; pending {
+		t.Fatal("the game is still pending once league-v4 counted it")
+	}
+
+	after, _ := c.lastLeagueSnapshot(acc.Puuid, "RANKED_SOLO_5x5")
+	if after.MatchId != match.Metadata.MatchID || after.LeaguePoints != 48 {
+		t.Fatalf("last snapshot = %+v, want 48 LP after %s", after, match.Metadata.MatchID)
+	}
+}
+
+func TestPendingLeagueGivesUp(t *testing.T) {
+	client, hits := fakeRiot(t, func(hit int32, w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"queueType": "RANKED_SOLO_5x5", "tier": "GOLD", "rank": "II", "leaguePoints": 40, "wins": 10, "losses": 10}]`))
+	})
+
+	c := newTestClient(t)
+	c.lolClient = client
+	match := loadMatch(t, "EUW1_6612345678")
+	acc := Account{Name: "Keko", Puuid: "puuid-keko", Id: "summoner-keko", Platform: "euw1"}
+
+	c.snapshotLeagues(acc)
+	c.trackLeagueChange(acc, match)
+
+	for i := 0; i < maxLeagueRetries+2; i++ {
+		c.retryPendingLeagues()
+	}
+
+	if len(c.pendingLeagues) != 0 {
+		t.Fatal("the game is still pending after every retry")
+	}
+	// the snapshot, the announcement and one request per retry
+	if want := int32(2 + maxLeagueRetries); *hits != want {
+		t.Fatalf("got %d requests, want %d", *hits, want)
+	}
+}
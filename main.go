@@ -30,10 +30,15 @@ func main() {
 	adminId := os.Getenv("ADMIN")
 	groupId := os.Getenv("GROUP")
 	apiKey := os.Getenv("API_KEY")
+	platformUrl := os.Getenv("RIOT_PLATFORM_URL")
+	regionalUrl := os.Getenv("RIOT_REGIONAL_URL")
+	templatesPath := os.Getenv("TEMPLATES_PATH")
+	rulesPath := os.Getenv("RULES_PATH")
+	ddragonDir := os.Getenv("DDRAGON_DIR")
 
 	wppClient := NewWppClient(dbPath, apiKey)
-	lolClient := NewLolClient(apiKey)
-	leviBot := NewLeviClient(wppClient, lolClient, groupId, adminId)
+	lolClient := NewLolClient(apiKey, platformUrl, regionalUrl)
+	leviBot := NewLeviClient(wppClient, lolClient, groupId, adminId, templatesPath, rulesPath, ddragonDir)
 
 	wppClient.AddEventHandler(leviBot.CommandHandler)
 	leviBot.CheckForNewMatches()
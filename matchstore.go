@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"time"
+)
+
+// Amount of past matches stored when a new account is added.
+const backfillSize = 20
+
+// storeMatch saves the match and the stats of the given players, matches and
+// participants already in the database are left untouched.
+func (c *LeviClient) storeMatch(match Match, puuids []string) {
+	record := MatchRecord{
+		MatchId:   match.Metadata.MatchID,
+		Platform:  platformFromMatchId(match.Metadata.MatchID),
+		QueueId:   match.Info.QueueID,
+		GameMode:  match.Info.GameMode,
+		Duration:  match.Info.GameDuration,
+		Timestamp: match.Info.GameEndTimestamp,
+	}
+	c.db.Where(MatchRecord{MatchId: record.MatchId}).FirstOrCreate(&record)
+
+	for _, puuid := range puuids {
+		for _, p := range match.Info.Participants {
+			if p.Puuid != puuid {
+				continue
+			}
+
+			participant := participantRecord(match, p)
+			c.db.Where(ParticipantRecord{MatchId: participant.MatchId, Puuid: puuid}).
+				FirstOrCreate(&participant)
+		}
+	}
+}
+
+func participantRecord(match Match, p Participant) ParticipantRecord {
+	return ParticipantRecord{
+		MatchId:      match.Metadata.MatchID,
+		Puuid:        p.Puuid,
+		ChampionName: p.ChampionName,
+		Role:         p.TeamPosition,
+		QueueId:      match.Info.QueueID,
+		TeamId:       p.TeamID,
+		Kills:        p.Kills,
+		Deaths:       p.Deaths,
+		Assists:      p.Assists,
+		Damage:       p.TotalDamageDealtToChampions,
+		CreepScore:   p.TotalMinionsKilled + p.NeutralMinionsKilled,
+		VisionScore:  p.VisionScore,
+		Pings:        p.TotalPings(),
+		Win:          p.Win,
+		Remake:       classifyMatch(match, p) == OutcomeRemake,
+		Duration:     match.Info.GameDuration,
+		Timestamp:    match.Info.GameEndTimestamp,
+	}
+}
+
+// lastPlayed returns when the newest stored match of an account ended. The
+// backfill stores the history of new accounts, so it works before their
+// first announced game too.
+func (c *LeviClient) lastPlayed(puuid string) (time.Time, bool) {
+	var record ParticipantRecord
+	if c.db.Where(&ParticipantRecord{Puuid: puuid}).Order("timestamp desc").Limit(1).Find(&record).RowsAffected == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(record.Timestamp/1000, 0), true
+}
+
+// trackedPuuidsIn returns the tracked accounts that played the match.
+func (c *LeviClient) trackedPuuidsIn(match Match) []string {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	var puuids []string
+	for _, p := range match.Info.Participants {
+		if _, ok := c.playerCache[p.Puuid]; ok {
+			puuids = append(puuids, p.Puuid)
+		}
+	}
+
+	return puuids
+}
+
+// backfillMatches stores the last matches of a newly added account so stats
+// commands have some history to work with.
+func (c *LeviClient) backfillMatches(acc Account) {
+	ids, err := c.lolClient.GetMatchIds(acc.Platform, acc.Puuid, 0, backfillSize)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not backfill matches of %s: %s", acc.RiotId(), err)
+		return
+	}
+
+	stored := 0
+	for _, id := range ids {
+		var count int64
+		c.db.Model(&ParticipantRecord{}).Where("match_id = ? AND puuid = ?", id, acc.Puuid).Count(&count)
+		if count > 0 {
+			continue
+		}
+
+		match, err := c.lolClient.GetMatchById(id)
+
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			c.wppClient.Log.Errorf("Could not backfill match %s of %s: %s", id, acc.RiotId(), err)
+			return
+		}
+
+		c.storeMatch(match, []string{acc.Puuid})
+		stored++
+	}
+
+	c.wppClient.Log.Infof("Backfilled %d matches of %s", stored, acc.RiotId())
+}
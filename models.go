@@ -8,4 +8,155 @@ type Account struct {
 	Accountid string
 	Id        string
 	Puuid     string
+	Platform  string `gorm:"default:euw1"`
+	GameName  string
+	TagLine   string
+}
+
+// RiotId returns the gameName#tagLine of the account, accounts added by
+// summoner name before Riot IDs existed fall back to that name.
+func (a Account) RiotId() string {
+	if a.GameName == "" {
+		return a.Name
+	}
+
+	return a.GameName + "#" + a.TagLine
+}
+
+// TrackingState is the last match announced for an account, polling resumes
+// from it after a restart so games finished while the bot was down are not
+// lost.
+type TrackingState struct {
+	gorm.Model
+	Puuid         string `gorm:"uniqueIndex"`
+	LastMatchId   string
+	LastMatchTime int64
+}
+
+// MatchRecord is a match played by at least one tracked account, games
+// shared by several of them are stored once.
+type MatchRecord struct {
+	gorm.Model
+	MatchId   string `gorm:"uniqueIndex"`
+	Platform  string
+	QueueId   int
+	GameMode  string
+	Duration  int
+	Timestamp int64
+}
+
+// ParticipantRecord holds the stats of a tracked account in a match, enough
+// to answer stats commands without calling Riot again.
+type ParticipantRecord struct {
+	gorm.Model
+	MatchId      string `gorm:"uniqueIndex:idx_participant_match_puuid"`
+	Puuid        string `gorm:"uniqueIndex:idx_participant_match_puuid;index"`
+	ChampionName string
+	Role         string
+	QueueId      int
+	TeamId       int
+	Kills        int
+	Deaths       int
+	Assists      int
+	Damage       int
+	CreepScore   int
+	VisionScore  int
+	Pings        int
+	Win          bool
+	// remakes are kept for the history but left out of stats and streaks
+	Remake    bool
+	Duration  int
+	Timestamp int64
+}
+
+// LeagueSnapshot is a league-v4 entry of an account at some point, one is
+// taken after every ranked game to know how much LP it was worth.
+type LeagueSnapshot struct {
+	gorm.Model
+	Puuid        string `gorm:"index"`
+	QueueType    string
+	Tier         string
+	Rank         string
+	LeaguePoints int
+	Wins         int
+	Losses       int
+	MiniSeries   string
+	MatchId      string
+}
+
+// GroupSettings are the per group preferences of the bot.
+type GroupSettings struct {
+	gorm.Model
+	GroupJID    string `gorm:"uniqueIndex"`
+	QueueFilter QueueFilter
+	// remakes get a short message unless they are skipped
+	SkipRemakes bool
+	// announcement template, empty for the default one
+	Template string
+	// WhatsApp admins of the group are bot admins too
+	WhatsappAdmins bool
+	// announcements are sent as text only, without the gold chart
+	SkipCharts bool
+	// every tracked player gets a scorecard image instead of the chart
+	Scorecards bool
+}
+
+// Streak is the current run of an account in a queue, positive for wins
+// and negative for losses.
+type Streak struct {
+	gorm.Model
+	Puuid   string `gorm:"uniqueIndex:idx_streak_puuid_queue"`
+	QueueId int    `gorm:"uniqueIndex:idx_streak_puuid_queue"`
+	Count   int
+}
+
+// Phrase is a roast or praise added to announcements. The optional
+// conditions restrict it to a champion, a player or games with more than
+// some deaths, and Weight makes it more or less likely to be picked.
+type Phrase struct {
+	gorm.Model
+	// GroupJID is the group that added the phrase, empty for the shared ones
+	// every group gets, like the defaults
+	GroupJID   string `gorm:"index"`
+	Category   string `gorm:"index"`
+	Text       string
+	Champion   string
+	Puuid      string
+	DeathsOver *int
+	Weight     int `gorm:"default:1"`
+}
+
+// SeededCategory marks a phrase category whose default phrases were
+// stored, so deleting all of them doesn't bring them back on the next start.
+type SeededCategory struct {
+	Category string `gorm:"primaryKey"`
+}
+
+// Subscription is a group following an account, an account is polled once
+// no matter how many groups follow it. MutedQueues are the queues of the
+// account the group doesn't want to hear about.
+type Subscription struct {
+	gorm.Model
+	GroupJID    string `gorm:"uniqueIndex:idx_subscription_group_puuid"`
+	Puuid       string `gorm:"uniqueIndex:idx_subscription_group_puuid"`
+	MutedQueues QueueFilter
+}
+
+// MemberRole is the role granted to a user in a group, users without one
+// are members.
+type MemberRole struct {
+	gorm.Model
+	GroupJID string `gorm:"uniqueIndex:idx_member_role_group_user"`
+	User     string `gorm:"uniqueIndex:idx_member_role_group_user"`
+	Role     Role
+}
+
+// LiveGame is the "in game" message sent to a group, the result of the
+// match quotes it once it ends.
+type LiveGame struct {
+	gorm.Model
+	MatchId   string `gorm:"uniqueIndex:idx_live_game_match_group"`
+	GroupJID  string `gorm:"uniqueIndex:idx_live_game_match_group"`
+	MessageId string
+	Text      string
 }
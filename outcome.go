@@ -0,0 +1,46 @@
+package main
+
+// Surrenders before this many seconds are the 15 minute early surrenders.
+const earlySurrenderDuration = 20 * 60
+
+type MatchOutcome int
+
+const (
+	OutcomeNormal MatchOutcome = iota
+	OutcomeRemake
+	OutcomeEarlySurrender
+	OutcomeSurrender
+)
+
+// classifyMatch tells remakes and surrenders apart from games that ended on
+// a destroyed nexus. Remakes set gameEndedInEarlySurrender, regular
+// surrenders set gameEndedInSurrender.
+func classifyMatch(match Match, p Participant) MatchOutcome {
+	switch {
+	case p.GameEndedInEarlySurrender:
+		return OutcomeRemake
+	case p.GameEndedInSurrender && match.Info.GameDuration < earlySurrenderDuration:
+		return OutcomeEarlySurrender
+	case p.GameEndedInSurrender:
+		return OutcomeSurrender
+	default:
+		return OutcomeNormal
+	}
+}
+
+// surrenderLabel is appended to the result of surrendered games, the
+// losing side is the one that surrendered.
+func surrenderLabel(outcome MatchOutcome, win bool) string {
+	switch {
+	case outcome == OutcomeEarlySurrender && win:
+		return " (se rindieron a los 15)"
+	case outcome == OutcomeEarlySurrender:
+		return " (FF a los 15)"
+	case outcome == OutcomeSurrender && win:
+		return " (se rindieron)"
+	case outcome == OutcomeSurrender:
+		return " (rendicion)"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import "testing"
+
+func TestClassifyMatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		duration       int
+		earlySurrender bool
+		surrender      bool
+		outcome        MatchOutcome
+	}{
+		{"nexus", 30 * 60, false, false, OutcomeNormal},
+		{"remake", 3 * 60, true, false, OutcomeRemake},
+		{"ff at 15", 16 * 60, false, true, OutcomeEarlySurrender},
+		{"ff at 20", earlySurrenderDuration, false, true, OutcomeSurrender},
+		{"late ff", 28 * 60, false, true, OutcomeSurrender},
+	}
+
+	for _, tt := range tests {
+		var match Match
+		match.Info.GameDuration = tt.duration
+		p := Participant{GameEndedInEarlySurrender: tt.earlySurrender, GameEndedInSurrender: tt.surrender}
+
+		if got := classifyMatch(match, p); got != tt.outcome {
+			t.Errorf("%s: classifyMatch = %d, want %d", tt.name, got, tt.outcome)
+		}
+	}
+}
+
+func TestSurrenderLabel(t *testing.T) {
+	tests := []struct {
+		outcome MatchOutcome
+		win     bool
+		label   string
+	}{
+		{OutcomeNormal, true, ""},
+		{OutcomeNormal, false, ""},
+		{OutcomeRemake, false, ""},
+		{OutcomeEarlySurrender, true, " (se rindieron a los 15)"},
+		{OutcomeEarlySurrender, false, " (FF a los 15)"},
+		{OutcomeSurrender, true, " (se rindieron)"},
+		{OutcomeSurrender, false, " (rendicion)"},
+	}
+
+	for _, tt := range tests {
+		if got := surrenderLabel(tt.outcome, tt.win); got != tt.label {
+			t.Errorf("surrenderLabel(%d, %v) = %q, want %q", tt.outcome, tt.win, got, tt.label)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+	"gorm.io/gorm"
+)
+
+// How many of the last picked phrases of a category are avoided.
+const recentPhrasesSize = 3
+
+// Categories a phrase can belong to, streak phrases go with win streaks and
+// promotion and demotion ones with rank changes. The ones after demotion are
+// picked by the default rules.
+var phraseCategories = []string{
+	"win", "loss", "remake", "pentakill", "streak", "promotion", "demotion",
+	"feeder", "pinger", "passenger", "nodamage", "blind", "firstblood",
+}
+
+// The phrases the bot shipped with, stored once per category as shared
+// phrases.
+var defaultPhrases = map[string][]string{
+	"loss": {
+		"es mas malo que la infancia en siria!",
+		"es peor que la morgana de biche!",
+		"juega peor que el depor!",
+		"esta una partida mas cerca del descenso, como el atleti!",
+		"se merece un relojazo!",
+		"deberia haber estudiao!",
+		"tiene menos elo que pelo gibe!",
+		"tira palla bobo!",
+	},
+	"win": {
+		"ha chupado otro carrito!",
+		"ha ganado de puto milagro!",
+		"ha sobornado a negreira!",
+		"parece VINI JR!",
+		"va de relojazo en relojazo!",
+		"se ha ganado unos kekos!",
+		"ES IMPARABLE!",
+		"ha despertado a pos!",
+	},
+	"pentakill": {
+		"ha hecho una PENTAKILL!",
+		"ha limpiado la grieta entera, PENTAKILL!",
+	},
+	"feeder": {
+		"ha muerto mas veces que un personaje de juego de tronos!",
+		"le ha pagado el alquiler al equipo enemigo!",
+	},
+	"pinger": {
+		"ha pingeado mas que jugado!",
+		"tiene el raton desgastado de tanto pingear!",
+	},
+	"passenger": {
+		"ha visto la partida desde la grada!",
+		"estaba de turismo por la grieta!",
+	},
+	"nodamage": {
+		"ha hecho menos daño que una pistola de agua!",
+		"ha sido el que menos pegaba del equipo!",
+	},
+	"blind": {
+		"ha jugado con los ojos cerrados!",
+		"no ha puesto un ward ni por error!",
+	},
+	"firstblood": {
+		"se ha llevado la primera sangre!",
+	},
+}
+
+func isPhraseCategory(category string) bool {
+	for _, c := range phraseCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// seedPhrases stores the default phrases of every category that wasn't
+// seeded yet, so categories added later get theirs too. Categories that
+// already have phrases from before the seeds were recorded are only marked.
+func (c *LeviClient) seedPhrases() {
+	categories := make([]string, 0, len(defaultPhrases))
+	for category := range defaultPhrases {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	for _, category := range categories {
+		var seeded int64
+		c.db.Model(&SeededCategory{}).Where("category = ?", category).Count(&seeded)
+
+		if seeded > 0 {
+			continue
+		}
+
+		var count int64
+		c.db.Model(&Phrase{}).Where("category = ?", category).Count(&count)
+
+		if count == 0 {
+			for _, text := range defaultPhrases[category] {
+				c.db.Create(&Phrase{Category: category, Text: text, Weight: 1})
+			}
+		}
+
+		c.db.Create(&SeededCategory{Category: category})
+	}
+}
+
+// Matches reports whether the conditions of the phrase hold for the player.
+func (p Phrase) Matches(acc Account, participant Participant) bool {
+	if p.Champion != "" && !strings.EqualFold(p.Champion, participant.ChampionName) {
+		return false
+	}
+
+	if p.Puuid != "" && p.Puuid != acc.Puuid {
+		return false
+	}
+
+	if p.DeathsOver != nil && participant.Deaths <= *p.DeathsOver {
+		return false
+	}
+
+	return true
+}
+
+// Conditions describes the conditions of the phrase for listings, player
+// is the name of the account the phrase is restricted to.
+func (p Phrase) Conditions(player string) string {
+	var conditions []string
+
+	if p.Champion != "" {
+		conditions = append(conditions, "champion="+p.Champion)
+	}
+
+	if player != "" {
+		conditions = append(conditions, "player="+player)
+	}
+
+	if p.DeathsOver != nil {
+		conditions = append(conditions, fmt.Sprintf("deaths>%d", *p.DeathsOver))
+	}
+
+	if p.Weight != 1 {
+		conditions = append(conditions, fmt.Sprintf("weight=%d", p.Weight))
+	}
+
+	return strings.Join(conditions, " ")
+}
+
+// groupPhrases returns the query of the phrases a group sees, its own and
+// the shared ones.
+func (c *LeviClient) groupPhrases(group types.JID) *gorm.DB {
+	return c.db.Where("group_j_id IN ?", []string{"", group.String()})
+}
+
+// pickPhrase returns a weighted random phrase of the category whose
+// conditions hold, avoiding the last ones picked in the group.
+func (c *LeviClient) pickPhrase(group types.JID, category string, acc Account, participant Participant) string {
+	var phrases []Phrase
+	c.groupPhrases(group).Where("category = ?", category).Find(&phrases)
+
+	var candidates []Phrase
+	for _, phrase := range phrases {
+		if phrase.Matches(acc, participant) {
+			candidates = append(candidates, phrase)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	c.phrasesMu.Lock()
+	defer c.phrasesMu.Unlock()
+
+	key := group.String() + "/" + category
+	recent := c.recentPhrases[key]
+	fresh := make([]Phrase, 0, len(candidates))
+	for _, phrase := range candidates {
+		if !containsUint(recent, phrase.ID) {
+			fresh = append(fresh, phrase)
+		}
+	}
+
+	// every candidate was used lately, repeating is better than nothing
+	if len(fresh) == 0 {
+		fresh = candidates
+	}
+
+	picked := weightedPhrase(fresh)
+
+	recent = append(recent, picked.ID)
+	if len(recent) > recentPhrasesSize {
+		recent = recent[len(recent)-recentPhrasesSize:]
+	}
+	c.recentPhrases[key] = recent
+
+	return picked.Text
+}
+
+func weightedPhrase(phrases []Phrase) Phrase {
+	total := 0
+	for _, phrase := range phrases {
+		total += maxInt(phrase.Weight, 1)
+	}
+
+	n := rand.Intn(total)
+	for _, phrase := range phrases {
+		n -= maxInt(phrase.Weight, 1)
+		if n < 0 {
+			return phrase
+		}
+	}
+
+	return phrases[len(phrases)-1]
+}
+
+// withPhrase appends a phrase of the category to an announcement, if any.
+func (c *LeviClient) withPhrase(group types.JID, msg, category string, acc Account) string {
+	if phrase := c.pickPhrase(group, category, acc, Participant{}); phrase != "" {
+		return msg + " " + phrase
+	}
+
+	return msg
+}
+
+func containsUint(list []uint, v uint) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestSeedPhrasesOnce(t *testing.T) {
+	c := newTestClient(t)
+	c.seedPhrases()
+
+	var count int64
+	c.db.Model(&Phrase{}).Where("category = ?", "loss").Count(&count)
+	if int(count) != len(defaultPhrases["loss"]) {
+		t.Fatalf("seeded %d loss phrases, want %d", count, len(defaultPhrases["loss"]))
+	}
+
+	// .delphrase of every loss phrase, then a restart
+	c.db.Unscoped().Where("category = ?", "loss").Delete(&Phrase{})
+	c.seedPhrases()
+
+	c.db.Model(&Phrase{}).Where("category = ?", "loss").Count(&count)
+	if count != 0 {
+		t.Errorf("the deleted loss phrases were seeded again, got %d", count)
+	}
+
+	c.db.Model(&Phrase{}).Where("category = ?", "win").Count(&count)
+	if int(count) != len(defaultPhrases["win"]) {
+		t.Errorf("win phrases = %d after seeding twice, want %d", count, len(defaultPhrases["win"]))
+	}
+}
+
+func TestSeedPhrasesMarksExistingCategories(t *testing.T) {
+	c := newTestClient(t)
+
+	// a database from before the seeds were recorded
+	c.db.Create(&Phrase{Category: "loss", Text: "tira palla bobo!", Weight: 1})
+	c.seedPhrases()
+
+	var count int64
+	c.db.Model(&Phrase{}).Where("category = ?", "loss").Count(&count)
+	if count != 1 {
+		t.Errorf("loss phrases = %d, want the existing one only", count)
+	}
+
+	c.db.Model(&SeededCategory{}).Where("category = ?", "loss").Count(&count)
+	if count != 1 {
+		t.Errorf("the existing loss category wasn't marked as seeded")
+	}
+}
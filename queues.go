@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const customQueueId = 0
+
+// queueNames maps match-v5 queue ids to the name shown in announcements.
+var queueNames = map[int]string{
+	0:    "Personalizada",
+	400:  "Normal (Reclutamiento)",
+	420:  "Ranked Solo/Duo",
+	430:  "Normal (Ciega)",
+	440:  "Ranked Flex",
+	450:  "ARAM",
+	480:  "Swiftplay",
+	490:  "Normal (Quickplay)",
+	700:  "Clash",
+	720:  "ARAM Clash",
+	830:  "Co-op vs IA (Intro)",
+	840:  "Co-op vs IA (Principiante)",
+	850:  "Co-op vs IA (Intermedio)",
+	870:  "Co-op vs IA (Intro)",
+	880:  "Co-op vs IA (Principiante)",
+	890:  "Co-op vs IA (Intermedio)",
+	900:  "URF",
+	1010: "URF Nevado",
+	1020: "Uno para todos",
+	1300: "Nexus Blitz",
+	1400: "Libro de hechizos definitivo",
+	1700: "Arena",
+	1710: "Arena",
+	1900: "URF",
+}
+
+// queueGroups are the names that can be used in queue filters besides the
+// numeric queue ids.
+var queueGroups = map[string][]int{
+	"ranked": {420, 440},
+	"solo":   {420},
+	"flex":   {440},
+	"normal": {400, 430, 480, 490},
+	"aram":   {450, 720},
+	"arena":  {1700, 1710},
+	"urf":    {900, 1010, 1900},
+	"clash":  {700, 720},
+	"coop":   {830, 840, 850, 870, 880, 890},
+	"custom": {customQueueId},
+}
+
+func queueName(queueId int) string {
+	if name, ok := queueNames[queueId]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Cola %d", queueId)
+}
+
+// QueueFilter decides which queues get announced. It is a list of queue
+// groups or ids where a leading "-" excludes them, e.g. "ranked",
+// "all -custom" or "420 440 aram". An empty filter allows everything.
+type QueueFilter string
+
+// Allows reports whether games of the queue pass the filter.
+func (f QueueFilter) Allows(queueId int) bool {
+	included := false
+	hasIncludes := false
+
+	for _, token := range strings.Fields(string(f)) {
+		exclude := strings.HasPrefix(token, "-")
+		matches := queueTokenMatches(strings.TrimPrefix(token, "-"), queueId)
+
+		if exclude {
+			if matches {
+				return false
+			}
+			continue
+		}
+
+		hasIncludes = true
+		if matches {
+			included = true
+		}
+	}
+
+	return included || !hasIncludes
+}
+
+// Matches reports whether the queue is listed in the filter, used for the
+// per account mutes where there are no exclusions.
+func (f QueueFilter) Matches(queueId int) bool {
+	return strings.TrimSpace(string(f)) != "" && f.Allows(queueId)
+}
+
+func queueTokenMatches(token string, queueId int) bool {
+	if token == "all" {
+		return true
+	}
+
+	if ids, ok := queueGroups[token]; ok {
+		for _, id := range ids {
+			if id == queueId {
+				return true
+			}
+		}
+		return false
+	}
+
+	id, err := strconv.Atoi(token)
+	return err == nil && id == queueId
+}
+
+// isQueueToken reports whether token is a valid queue filter token.
+func isQueueToken(token string) bool {
+	token = strings.TrimPrefix(strings.ToLower(token), "-")
+	if token == "all" {
+		return true
+	}
+
+	if _, ok := queueGroups[token]; ok {
+		return true
+	}
+
+	_, err := strconv.Atoi(token)
+	return err == nil
+}
+
+// parseQueueFilter validates and normalizes the tokens of a filter.
+func parseQueueFilter(tokens []string) (QueueFilter, error) {
+	var normalized []string
+
+	for _, token := range tokens {
+		for _, t := range strings.Split(token, ",") {
+			if t == "" {
+				continue
+			}
+
+			if !isQueueToken(t) {
+				return "", fmt.Errorf("no conozco la cola %s, usa %s o un id", t, queueGroupNames())
+			}
+
+			normalized = append(normalized, strings.ToLower(t))
+		}
+	}
+
+	return QueueFilter(strings.Join(normalized, " ")), nil
+}
+
+func queueGroupNames() string {
+	names := []string{"all"}
+	for name := range queueGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,53 @@
+package main
+
+import "testing"
+
+func TestQueueFilterAllows(t *testing.T) {
+	tests := []struct {
+		filter  QueueFilter
+		queueId int
+		allows  bool
+	}{
+		{"", 420, true},
+		{"", customQueueId, true},
+		{"ranked", 420, true},
+		{"ranked", 440, true},
+		{"ranked", 450, false},
+		{"all -custom", 450, true},
+		{"all -custom", customQueueId, false},
+		{"420 440 aram", 720, true},
+		{"420 440 aram", 430, false},
+		{"-aram", 420, true},
+		{"-aram", 450, false},
+		{"ranked -flex", 440, false},
+		{"ranked -flex", 420, true},
+		{"1700", 1710, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Allows(tt.queueId); got != tt.allows {
+			t.Errorf("QueueFilter(%q).Allows(%d) = %v, want %v", tt.filter, tt.queueId, got, tt.allows)
+		}
+	}
+}
+
+func TestQueueFilterMatches(t *testing.T) {
+	tests := []struct {
+		filter  QueueFilter
+		queueId int
+		matches bool
+	}{
+		{"", 420, false},
+		{"  ", 420, false},
+		{"aram", 450, true},
+		{"aram", 420, false},
+		{"normal arena", 1700, true},
+		{"all", customQueueId, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Matches(tt.queueId); got != tt.matches {
+			t.Errorf("QueueFilter(%q).Matches(%d) = %v, want %v", tt.filter, tt.queueId, got, tt.matches)
+		}
+	}
+}
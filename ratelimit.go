@@ -0,0 +1,222 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Riot development keys start with these limits, they get replaced with
+// whatever the API reports back in the X-App-Rate-Limit header.
+const defaultAppRateLimit = "20:1,100:120"
+
+// rateWindow is a single "limit:seconds" pair of a Riot rate limit header.
+type rateWindow struct {
+	limit  int
+	period time.Duration
+	count  int
+	reset  time.Time
+}
+
+// rateBucket holds every window that applies to the same key, callers wait
+// until all the windows have room for one more request.
+type rateBucket struct {
+	mu           sync.Mutex
+	windows      []*rateWindow
+	blockedUntil time.Time
+}
+
+// RateLimiter keeps one application bucket per routing host (euw1, europe..)
+// and one method bucket per routing host and endpoint.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*rateBucket
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{buckets: map[string]*rateBucket{}}
+}
+
+func (r *RateLimiter) appBucket(routing string) *rateBucket {
+	return r.bucket("app:"+routing, defaultAppRateLimit)
+}
+
+func (r *RateLimiter) methodBucket(routing, method string) *rateBucket {
+	return r.bucket("method:"+routing+":"+method, "")
+}
+
+func (r *RateLimiter) bucket(key, limits string) *rateBucket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.buckets[key]
+	if !ok {
+		b = &rateBucket{windows: parseRateWindows(limits)}
+		r.buckets[key] = b
+	}
+
+	return b
+}
+
+// Wait blocks until a request to the given routing host and method is
+// allowed by both the method and the application limits.
+func (r *RateLimiter) Wait(routing, method string) {
+	r.methodBucket(routing, method).take()
+	r.appBucket(routing).take()
+}
+
+// Update syncs the buckets with the limits and counts reported by Riot and
+// blocks them when the response asks us to back off.
+func (r *RateLimiter) Update(routing, method string, res *http.Response) {
+	app := r.appBucket(routing)
+	met := r.methodBucket(routing, method)
+
+	app.sync(res.Header.Get("X-App-Rate-Limit"), res.Header.Get("X-App-Rate-Limit-Count"))
+	met.sync(res.Header.Get("X-Method-Rate-Limit"), res.Header.Get("X-Method-Rate-Limit-Count"))
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+	if res.Header.Get("X-Rate-Limit-Type") == "application" {
+		app.block(retryAfter)
+	} else {
+		// method and service limits only affect the endpoint that was hit
+		met.block(retryAfter)
+	}
+}
+
+// take waits until every window has room and counts the request. The lock
+// is released while sleeping so Update can still record new limits or a
+// 429 for the bucket, they are checked again on wake up.
+func (b *rateBucket) take() {
+	for {
+		wait := b.tryTake()
+		if wait <= 0 {
+			return
+		}
+
+		time.Sleep(wait)
+	}
+}
+
+// tryTake counts the request when the bucket has room, otherwise it returns
+// how long to wait before trying again.
+func (b *rateBucket) tryTake() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	wait := b.blockedUntil.Sub(now)
+
+	for _, w := range b.windows {
+		if !now.Before(w.reset) {
+			w.count = 0
+		}
+
+		if w.count >= w.limit {
+			if d := w.reset.Sub(now); d > wait {
+				wait = d
+			}
+		}
+	}
+
+	if wait > 0 {
+		return wait
+	}
+
+	for _, w := range b.windows {
+		if w.count == 0 {
+			w.reset = now.Add(w.period)
+		}
+		w.count++
+	}
+
+	return 0
+}
+
+func (b *rateBucket) sync(limits, counts string) {
+	if limits == "" {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	windows := parseRateWindows(limits)
+	current := parseRateWindows(counts)
+	now := time.Now()
+
+	for _, w := range windows {
+		// keep our own count for windows we already knew about
+		for _, old := range b.windows {
+			if old.period == w.period {
+				w.count, w.reset = old.count, old.reset
+			}
+		}
+
+		// Riot reports counts as "count:seconds"
+		for _, c := range current {
+			if c.period == w.period && c.limit > w.count {
+				w.count = c.limit
+			}
+		}
+
+		if w.reset.IsZero() {
+			w.reset = now.Add(w.period)
+		}
+	}
+
+	b.windows = windows
+}
+
+func (b *rateBucket) block(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// parseRateWindows parses headers like "20:1,100:120".
+func parseRateWindows(header string) []*rateWindow {
+	var windows []*rateWindow
+
+	for _, pair := range strings.Split(header, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			continue
+		}
+
+		limit, err := strconv.Atoi(parts[0])
+		if err != nil {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(parts[1])
+		if err != nil {
+			continue
+		}
+
+		windows = append(windows, &rateWindow{
+			limit:  limit,
+			period: time.Duration(seconds) * time.Second,
+		})
+	}
+
+	return windows
+}
+
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(header))
+	if err != nil || seconds <= 0 {
+		return time.Second
+	}
+
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRiot serves every endpoint with handler and counts the hits.
+func fakeRiot(t *testing.T, handler func(hit int32, w http.ResponseWriter, r *http.Request)) (*LolClient, *int32) {
+	var hits int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(atomic.AddInt32(&hits, 1), w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewLolClient("test-key", server.URL, server.URL), &hits
+}
+
+func TestRateBucketTake(t *testing.T) {
+	b := &rateBucket{windows: parseRateWindows("2:1")}
+
+	start := time.Now()
+	b.take()
+	b.take()
+
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("requests within the limit waited %s", elapsed)
+	}
+
+	b.take()
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("third request in a 2:1 window only waited %s", elapsed)
+	}
+}
+
+func TestRateBucketBlockWhileWaiting(t *testing.T) {
+	b := &rateBucket{windows: parseRateWindows("1:1")}
+	b.take()
+
+	done := make(chan time.Time)
+	go func() {
+		b.take()
+		done <- time.Now()
+	}()
+
+	// a 429 arrives while the second request waits for the window
+	time.Sleep(100 * time.Millisecond)
+	start := time.Now()
+	b.block(2 * time.Second)
+
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("block waited %s for the queued request", elapsed)
+	}
+
+	if end := <-done; end.Sub(start) < 1900*time.Millisecond {
+		t.Fatalf("queued request ignored the block, sent after %s", end.Sub(start))
+	}
+}
+
+func TestRateLimiterSyncsCounts(t *testing.T) {
+	client, hits := fakeRiot(t, func(hit int32, w http.ResponseWriter, r *http.Request) {
+		// the key was already used elsewhere, the window is full
+		w.Header().Set("X-App-Rate-Limit", "100:1")
+		w.Header().Set("X-Method-Rate-Limit", "1:1")
+		w.Header().Set("X-Method-Rate-Limit-Count", "1:1")
+		w.Write([]byte("{}"))
+	})
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetSummonerByName("euw1", "keko"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("second request ignored the reported count, took %s", elapsed)
+	}
+
+	if *hits != 2 {
+		t.Fatalf("got %d hits, want 2", *hits)
+	}
+}
+
+func TestRateLimiterRetriesAfter429(t *testing.T) {
+	client, hits := fakeRiot(t, func(hit int32, w http.ResponseWriter, r *http.Request) {
+		if hit == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.Header().Set("X-Rate-Limit-Type", "method")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"puuid": "puuid"}`))
+	})
+
+	start := time.Now()
+	summoner, err := client.GetSummonerByName("euw1", "keko")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summoner.Puuid != "puuid" {
+		t.Fatalf("got summoner %+v", summoner)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("retried before Retry-After, took %s", elapsed)
+	}
+
+	if *hits != 2 {
+		t.Fatalf("got %d hits, want 2", *hits)
+	}
+}
+
+func TestRateLimiterBlocks(t *testing.T) {
+	tests := []struct {
+		name      string
+		limitType string
+		// whether another endpoint of the same routing has to wait too
+		blocksOthers bool
+	}{
+		{"application limit", "application", true},
+		{"method limit", "method", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewRateLimiter()
+			res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+			res.Header.Set("Retry-After", "1")
+			res.Header.Set("X-Rate-Limit-Type", tt.limitType)
+
+			limiter.Update("euw1", "summoner-v4.by-name", res)
+
+			start := time.Now()
+			limiter.Wait("euw1", "league-v4.entries-by-summoner")
+			blocked := time.Since(start) >= 900*time.Millisecond
+
+			if blocked != tt.blocksOthers {
+				t.Fatalf("other endpoint blocked = %v, want %v", blocked, tt.blocksOthers)
+			}
+
+			start = time.Now()
+			limiter.Wait("euw1", "summoner-v4.by-name")
+
+			if !blocked && time.Since(start) < 900*time.Millisecond {
+				t.Fatalf("limited endpoint didn't wait for Retry-After")
+			}
+		})
+	}
+}
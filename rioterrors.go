@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrNotFound    = errors.New("riot: not found")
+	ErrForbidden   = errors.New("riot: forbidden, api key is invalid or expired")
+	ErrRateLimited = errors.New("riot: rate limited")
+	ErrServer      = errors.New("riot: server error")
+	ErrNoMatches   = errors.New("riot: no matches found")
+)
+
+// RiotError is returned by every LolClient method when the API answers with
+// a non 200 status. It unwraps to one of the Err* kinds above so callers can
+// use errors.Is, and errors.As to read the retry delay.
+type RiotError struct {
+	Kind       error
+	StatusCode int
+	Url        string
+	RetryAfter time.Duration
+}
+
+func (e *RiotError) Error() string {
+	if e.Kind == ErrRateLimited {
+		return fmt.Sprintf("%s (%d) retry after %s: %s", e.Kind, e.StatusCode, e.RetryAfter, e.Url)
+	}
+
+	return fmt.Sprintf("%s (%d): %s", e.Kind, e.StatusCode, e.Url)
+}
+
+func (e *RiotError) Unwrap() error {
+	return e.Kind
+}
+
+// checkResponse turns a non 200 response into a *RiotError.
+func checkResponse(res *http.Response) error {
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	riotErr := &RiotError{StatusCode: res.StatusCode, Url: res.Request.URL.Path}
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		riotErr.Kind = ErrNotFound
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		riotErr.Kind = ErrForbidden
+	case res.StatusCode == http.StatusTooManyRequests:
+		riotErr.Kind = ErrRateLimited
+		riotErr.RetryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
+	default:
+		riotErr.Kind = ErrServer
+	}
+
+	return riotErr
+}
+
+// describeRiotError returns a short message that can be sent to the group.
+func describeRiotError(err error) string {
+	var riotErr *RiotError
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "no existe en Riot"
+	case errors.Is(err, ErrNoMatches):
+		return "no tiene partidas"
+	case errors.Is(err, ErrForbidden):
+		return "la API key de Riot no es valida o ha caducado"
+	case errors.As(err, &riotErr) && riotErr.Kind == ErrRateLimited:
+		return fmt.Sprintf("Riot nos esta limitando, reintenta en %s", riotErr.RetryAfter)
+	case errors.Is(err, ErrServer):
+		return "los servidores de Riot estan fallando"
+	default:
+		return err.Error()
+	}
+}
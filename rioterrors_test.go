@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		status     int
+		retryAfter string
+		kind       error
+		delay      time.Duration
+	}{
+		{http.StatusOK, "", nil, 0},
+		{http.StatusNotFound, "", ErrNotFound, 0},
+		{http.StatusUnauthorized, "", ErrForbidden, 0},
+		{http.StatusForbidden, "", ErrForbidden, 0},
+		{http.StatusTooManyRequests, "7", ErrRateLimited, 7 * time.Second},
+		{http.StatusTooManyRequests, "", ErrRateLimited, time.Second},
+		{http.StatusInternalServerError, "", ErrServer, 0},
+		{http.StatusServiceUnavailable, "", ErrServer, 0},
+		{http.StatusBadRequest, "", ErrServer, 0},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{
+			StatusCode: tt.status,
+			Header:     http.Header{},
+			Request:    &http.Request{URL: &url.URL{Path: "/lol/match/v5/matches/EUW1_1"}},
+		}
+		if tt.retryAfter != "" {
+			res.Header.Set("Retry-After", tt.retryAfter)
+		}
+
+		err := checkResponse(res)
+		if tt.kind == nil {
+			if err != nil {
+				t.Errorf("checkResponse(%d) = %v, want nil", tt.status, err)
+			}
+			continue
+		}
+
+		var riotErr *RiotError
+		if !errors.As(err, &riotErr) {
+			t.Errorf("checkResponse(%d) = %v, want a *RiotError", tt.status, err)
+			continue
+		}
+		if !errors.Is(err, tt.kind) || riotErr.StatusCode != tt.status || riotErr.RetryAfter != tt.delay {
+			t.Errorf("checkResponse(%d) = %v (%d, retry %s), want %v (retry %s)",
+				tt.status, riotErr.Kind, riotErr.StatusCode, riotErr.RetryAfter, tt.kind, tt.delay)
+		}
+	}
+}
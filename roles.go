@@ -0,0 +1,90 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// How long the WhatsApp admins of a group are cached.
+const groupAdminsTTL = time.Minute * 5
+
+type groupAdmins struct {
+	users   map[string]bool
+	fetched time.Time
+}
+
+var roleNames = map[string]Role{
+	"member":    RoleMember,
+	"miembro":   RoleMember,
+	"moderator": RoleModerator,
+	"moderador": RoleModerator,
+	"mod":       RoleModerator,
+	"admin":     RoleAdmin,
+}
+
+// parseRole returns the role for names like "mod" or "admin", owner can't
+// be granted.
+func parseRole(name string) (Role, bool) {
+	role, ok := roleNames[strings.ToLower(name)]
+	return role, ok
+}
+
+// roleOf returns the permission level of the sender in a chat.
+func (c *LeviClient) roleOf(chat, sender types.JID) Role {
+	if sender.User == c.adminJID.User {
+		return RoleOwner
+	}
+
+	role := RoleMember
+
+	var member MemberRole
+	if c.db.Where(&MemberRole{GroupJID: chat.String(), User: sender.User}).Limit(1).Find(&member).RowsAffected > 0 {
+		role = member.Role
+	}
+
+	if role < RoleAdmin && chat.Server == types.GroupServer && c.groupSettings(chat).WhatsappAdmins {
+		if c.isWhatsappAdmin(chat, sender) {
+			role = RoleAdmin
+		}
+	}
+
+	return role
+}
+
+// setRole grants a role to the user in the group, members have no row.
+func (c *LeviClient) setRole(group types.JID, user string, role Role) {
+	c.db.Unscoped().Where(&MemberRole{GroupJID: group.String(), User: user}).Delete(&MemberRole{})
+
+	if role > RoleMember {
+		c.db.Create(&MemberRole{GroupJID: group.String(), User: user, Role: role})
+	}
+}
+
+// isWhatsappAdmin checks the user against the admins of the WhatsApp group.
+func (c *LeviClient) isWhatsappAdmin(group, user types.JID) bool {
+	c.groupAdminsMu.Lock()
+	defer c.groupAdminsMu.Unlock()
+
+	admins, ok := c.groupAdmins[group.String()]
+
+	if !ok || time.Since(admins.fetched) > groupAdminsTTL {
+		info, err := c.wppClient.GetGroupInfo(group)
+
+		if err != nil {
+			c.wppClient.Log.Errorf("Could not get group info of %s: %s", group, err)
+			return admins.users[user.User]
+		}
+
+		admins = groupAdmins{users: map[string]bool{}, fetched: time.Now()}
+		for _, p := range info.Participants {
+			if p.IsAdmin || p.IsSuperAdmin {
+				admins.users[p.JID.User] = true
+			}
+		}
+		c.groupAdmins[group.String()] = admins
+	}
+
+	return admins.users[user.User]
+}
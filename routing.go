@@ -0,0 +1,88 @@
+package main
+
+import "strings"
+
+const defaultPlatform = "euw1"
+
+// platformRegions maps every platform routing value to the regional cluster
+// used by match-v5 and account-v1.
+var platformRegions = map[string]string{
+	"br1":  "americas",
+	"la1":  "americas",
+	"la2":  "americas",
+	"na1":  "americas",
+	"eun1": "europe",
+	"euw1": "europe",
+	"me1":  "europe",
+	"ru":   "europe",
+	"tr1":  "europe",
+	"jp1":  "asia",
+	"kr":   "asia",
+	"oc1":  "sea",
+	"ph2":  "sea",
+	"sg2":  "sea",
+	"th2":  "sea",
+	"tw2":  "sea",
+	"vn2":  "sea",
+}
+
+// platformAliases are the names players usually type for a region.
+var platformAliases = map[string]string{
+	"br":   "br1",
+	"lan":  "la1",
+	"las":  "la2",
+	"na":   "na1",
+	"eune": "eun1",
+	"euw":  "euw1",
+	"me":   "me1",
+	"tr":   "tr1",
+	"jp":   "jp1",
+	"oce":  "oc1",
+	"ph":   "ph2",
+	"sg":   "sg2",
+	"th":   "th2",
+	"tw":   "tw2",
+	"vn":   "vn2",
+}
+
+// parsePlatform returns the platform routing value for names like "euw",
+// "EUW1" or "na", and false when it isn't a known platform.
+func parsePlatform(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if alias, ok := platformAliases[name]; ok {
+		return alias, true
+	}
+
+	_, ok := platformRegions[name]
+	return name, ok
+}
+
+// regionForPlatform returns the regional cluster of a platform, unknown
+// platforms fall back to the default one.
+func regionForPlatform(platform string) string {
+	if region, ok := platformRegions[strings.ToLower(platform)]; ok {
+		return region
+	}
+
+	return platformRegions[defaultPlatform]
+}
+
+// accountRegionForPlatform returns the cluster serving account-v1, which
+// isn't available on sea so those platforms go through asia.
+func accountRegionForPlatform(platform string) string {
+	if region := regionForPlatform(platform); region != "sea" {
+		return region
+	}
+
+	return "asia"
+}
+
+// platformFromMatchId extracts the platform from match ids like "EUW1_1234".
+func platformFromMatchId(matchId string) string {
+	if i := strings.Index(matchId, "_"); i > 0 {
+		return strings.ToLower(matchId[:i])
+	}
+
+	return defaultPlatform
+}
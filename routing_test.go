@@ -0,0 +1,53 @@
+package main
+
+import "testing"
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		ok       bool
+	}{
+		{"euw", "euw1", true},
+		{"EUW1", "euw1", true},
+		{" na ", "na1", true},
+		{"lan", "la1", true},
+		{"kr", "kr", true},
+		{"oce", "oc1", true},
+		{"atlantis", "atlantis", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		platform, ok := parsePlatform(tt.name)
+
+		if platform != tt.platform || ok != tt.ok {
+			t.Errorf("parsePlatform(%q) = %q, %v, want %q, %v", tt.name, platform, ok, tt.platform, tt.ok)
+		}
+	}
+}
+
+func TestRegionForPlatform(t *testing.T) {
+	tests := []struct {
+		platform      string
+		region        string
+		accountRegion string
+	}{
+		{"euw1", "europe", "europe"},
+		{"EUN1", "europe", "europe"},
+		{"na1", "americas", "americas"},
+		{"kr", "asia", "asia"},
+		{"oc1", "sea", "asia"},
+		{"vn2", "sea", "asia"},
+		{"atlantis", "europe", "europe"},
+	}
+
+	for _, tt := range tests {
+		if got := regionForPlatform(tt.platform); got != tt.region {
+			t.Errorf("regionForPlatform(%q) = %q, want %q", tt.platform, got, tt.region)
+		}
+		if got := accountRegionForPlatform(tt.platform); got != tt.accountRegion {
+			t.Errorf("accountRegionForPlatform(%q) = %q, want %q", tt.platform, got, tt.accountRegion)
+		}
+	}
+}
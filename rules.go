@@ -0,0 +1,352 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	_ "embed"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+const defaultRulesPath = "rules.json"
+
+// The default rules are compiled in so the bot works without the rules
+// file, a rules.json on disk takes precedence.
+//
+//go:embed rules.json
+var embeddedRules []byte
+
+// Rule sends the phrase of a player to a category when every one of its
+// conditions holds, and Result is empty or the result of the game. When
+// several rules match the one with the highest priority is tried first, so
+// the most remarkable fact about the game is the one called out.
+//
+// Conditions look like "deaths >= 10", "challenges.killParticipation < 0.3"
+// or just "firstBloodKill" for fields that only need to be true. Fields are
+// the json names of the match-v5 participant, plus the computed ones in
+// computedFields.
+type Rule struct {
+	Name     string   `json:"name"`
+	Priority int      `json:"priority"`
+	Result   string   `json:"result"`
+	When     []string `json:"when"`
+	Category string   `json:"category"`
+
+	conditions []condition
+}
+
+type condition struct {
+	field string
+	op    string
+	value float64
+}
+
+var ruleOps = map[string]func(a, b float64) bool{
+	">":  func(a, b float64) bool { return a > b },
+	">=": func(a, b float64) bool { return a >= b },
+	"<":  func(a, b float64) bool { return a < b },
+	"<=": func(a, b float64) bool { return a <= b },
+	"==": func(a, b float64) bool { return a == b },
+	"!=": func(a, b float64) bool { return a != b },
+}
+
+// computedFields are stats that need the rest of the match to be known.
+var computedFields = map[string]func(match Match, p Participant) float64{
+	// kp is the kill participation from 0 to 100
+	"kp": func(match Match, p Participant) float64 {
+		kills := 0
+		for _, other := range match.Info.Participants {
+			if other.TeamID == p.TeamID {
+				kills += other.Kills
+			}
+		}
+		if kills == 0 {
+			return 0
+		}
+		return float64(p.Kills+p.Assists) * 100 / float64(kills)
+	},
+	"kda": func(match Match, p Participant) float64 {
+		return float64(p.Kills+p.Assists) / float64(maxInt(p.Deaths, 1))
+	},
+	"pings": func(match Match, p Participant) float64 {
+		return float64(p.TotalPings())
+	},
+	"cspermin": func(match Match, p Participant) float64 {
+		if match.Info.GameDuration == 0 {
+			return 0
+		}
+		return float64(p.TotalMinionsKilled+p.NeutralMinionsKilled) * 60 / float64(match.Info.GameDuration)
+	},
+	"minutes": func(match Match, p Participant) float64 {
+		return float64(match.Info.GameDuration) / 60
+	},
+	"lowestteamdamage": func(match Match, p Participant) float64 {
+		for _, other := range match.Info.Participants {
+			if other.TeamID == p.TeamID && other.TotalDamageDealtToChampions < p.TotalDamageDealtToChampions {
+				return 0
+			}
+		}
+		return 1
+	},
+	"highestteamdamage": func(match Match, p Participant) float64 {
+		for _, other := range match.Info.Participants {
+			if other.TeamID == p.TeamID && other.TotalDamageDealtToChampions > p.TotalDamageDealtToChampions {
+				return 0
+			}
+		}
+		return 1
+	},
+}
+
+// parseRules decodes a rules file, checking every condition so a typo
+// doesn't silently disable a rule.
+func parseRules(content []byte) ([]Rule, error) {
+	var rules []Rule
+
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, err
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+
+		if len(rule.When) == 0 || rule.Category == "" {
+			return nil, fmt.Errorf("rule %s: needs conditions and a category", rule.Name)
+		}
+
+		if !isPhraseCategory(rule.Category) {
+			return nil, fmt.Errorf("rule %s: unknown category %q", rule.Name, rule.Category)
+		}
+
+		if rule.Result != "" && rule.Result != "win" && rule.Result != "loss" {
+			return nil, fmt.Errorf("rule %s: result has to be win, loss or empty", rule.Name)
+		}
+
+		for _, when := range rule.When {
+			cond, err := parseCondition(when)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+			}
+			rule.conditions = append(rule.conditions, cond)
+		}
+	}
+
+	// stable so rules with the same priority keep the order of the file
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority > rules[j].Priority
+	})
+
+	return rules, nil
+}
+
+func parseCondition(s string) (condition, error) {
+	parts := strings.Fields(s)
+
+	switch len(parts) {
+	case 1:
+		parts = append(parts, "!=", "0")
+	case 3:
+	default:
+		return condition{}, fmt.Errorf("bad condition %q, use \"field op value\"", s)
+	}
+
+	cond := condition{field: parts[0], op: parts[1]}
+
+	if _, ok := ruleOps[cond.op]; !ok {
+		return condition{}, fmt.Errorf("bad operator %q in %q", cond.op, s)
+	}
+
+	switch parts[2] {
+	case "true":
+		cond.value = 1
+	case "false":
+		cond.value = 0
+	default:
+		value, err := strconv.ParseFloat(parts[2], 64)
+		if err != nil {
+			return condition{}, fmt.Errorf("bad value %q in %q", parts[2], s)
+		}
+		cond.value = value
+	}
+
+	if _, ok := fieldValue(Match{}, Participant{}, cond.field); !ok {
+		return condition{}, fmt.Errorf("unknown field %q", cond.field)
+	}
+
+	return cond, nil
+}
+
+// Matches reports whether the rule applies to the result of the player and
+// every condition holds.
+func (r Rule) Matches(match Match, p Participant) bool {
+	if r.Result != "" && r.Result != resultCategory(p) {
+		return false
+	}
+
+	for _, cond := range r.conditions {
+		value, ok := fieldValue(match, p, cond.field)
+		if !ok || !ruleOps[cond.op](value, cond.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchCategories returns the phrase categories to try for a player, the
+// ones of the matching rules by priority and then the result of the game.
+// Rules must be sorted like parseRules leaves them.
+func matchCategories(rules []Rule, match Match, p Participant) []string {
+	if classifyMatch(match, p) == OutcomeRemake {
+		return []string{"remake"}
+	}
+
+	var categories []string
+	for _, rule := range rules {
+		if rule.Matches(match, p) && !containsString(categories, rule.Category) {
+			categories = append(categories, rule.Category)
+		}
+	}
+
+	return append(categories, resultCategory(p))
+}
+
+// resultCategory is "win" or "loss".
+func resultCategory(p Participant) string {
+	if p.Win {
+		return "win"
+	}
+
+	return "loss"
+}
+
+// fieldValue reads a computed field or a participant field by its json
+// name, ignoring case. Nested fields like challenges.kda use dots.
+func fieldValue(match Match, p Participant, field string) (float64, bool) {
+	if computed, ok := computedFields[strings.ToLower(field)]; ok {
+		return computed(match, p), true
+	}
+
+	v := reflect.ValueOf(p)
+	for _, name := range strings.Split(field, ".") {
+		if v.Kind() != reflect.Struct {
+			return 0, false
+		}
+
+		found := false
+		for i := 0; i < v.NumField(); i++ {
+			tag := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+			if strings.EqualFold(tag, name) {
+				v = v.Field(i)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return 0, false
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Float64:
+		return v.Float(), true
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// Rules loads the rules file, it is parsed again whenever it changes on
+// disk like the templates.
+type Rules struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+	rules   []Rule
+}
+
+func NewRules(path string) *Rules {
+	if path == "" {
+		path = defaultRulesPath
+	}
+
+	return &Rules{path: path}
+}
+
+// Get returns the current rules, falling back to the compiled in ones when
+// there is no rules file. A broken file keeps the previous rules.
+func (r *Rules) Get() ([]Rule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+
+	if err != nil {
+		if r.rules == nil || !r.modTime.IsZero() {
+			r.rules, r.modTime = r.embedded(), time.Time{}
+		}
+		return r.rules, nil
+	}
+
+	if r.rules != nil && r.modTime.Equal(info.ModTime()) {
+		return r.rules, nil
+	}
+
+	content, err := os.ReadFile(r.path)
+	if err == nil {
+		var rules []Rule
+		if rules, err = parseRules(content); err == nil {
+			r.rules, r.modTime = rules, info.ModTime()
+			return r.rules, nil
+		}
+	}
+
+	if r.rules == nil {
+		r.rules = r.embedded()
+	}
+
+	return r.rules, fmt.Errorf("%s: %w", r.path, err)
+}
+
+func (r *Rules) embedded() []Rule {
+	rules, err := parseRules(embeddedRules)
+
+	if err != nil {
+		panic(fmt.Sprintf("embedded rules: %s", err))
+	}
+
+	return rules
+}
+
+// matchPhrase is the phrase of a player in a match, picked from the first
+// category of matchCategories that has one for the player, so phrases with
+// conditions and weights work the same for rules.
+func (c *LeviClient) matchPhrase(group types.JID, match Match, acc Account, p Participant) string {
+	rules, err := c.rules.Get()
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not load rules: %s", err)
+	}
+
+	for _, category := range matchCategories(rules, match, p) {
+		if phrase := c.pickPhrase(group, category, acc, p); phrase != "" {
+			return phrase
+		}
+	}
+
+	return ""
+}
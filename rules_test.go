@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// loadMatch reads a recorded match-v5 response from testdata.
+func loadMatch(t *testing.T, matchId string) Match {
+	t.Helper()
+
+	content, err := os.ReadFile("testdata/match_" + matchId + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var match Match
+	if err := json.Unmarshal(content, &match); err != nil {
+		t.Fatal(err)
+	}
+
+	return match
+}
+
+func participantByName(t *testing.T, match Match, name string) Participant {
+	t.Helper()
+
+	for _, p := range match.Info.Participants {
+		if p.RiotIDGameName == name {
+			return p
+		}
+	}
+
+	t.Fatalf("no participant %s in %s", name, match.Metadata.MatchID)
+	return Participant{}
+}
+
+func TestDefaultRulesCategories(t *testing.T) {
+	rules, err := parseRules(embeddedRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := loadMatch(t, "EUW1_6612345678")
+
+	tests := []struct {
+		player     string
+		categories []string
+	}{
+		{"Keko", []string{"pentakill", "win"}},
+		{"Gibe", []string{"firstblood", "win"}},
+		// feeding doesn't count on a win
+		{"Biche", []string{"win"}},
+		{"Pos", []string{"blind", "win"}},
+		// lowest damage of the winners, only roasted on a loss
+		{"Rodri", []string{"win"}},
+		{"Enemy1", []string{"feeder", "loss"}},
+		{"Enemy2", []string{"pinger", "loss"}},
+		{"Enemy3", []string{"passenger", "loss"}},
+		{"Enemy4", []string{"nodamage", "loss"}},
+		{"Enemy5", []string{"feeder", "pinger", "loss"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.player, func(t *testing.T) {
+			p := participantByName(t, match, tt.player)
+
+			if got := matchCategories(rules, match, p); !reflect.DeepEqual(got, tt.categories) {
+				t.Errorf("matchCategories = %v, want %v", got, tt.categories)
+			}
+		})
+	}
+}
+
+func TestRemakeSkipsRules(t *testing.T) {
+	rules, err := parseRules(embeddedRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := loadMatch(t, "EUW1_6612345678")
+	p := participantByName(t, match, "Keko")
+	p.GameEndedInEarlySurrender = true
+
+	if got := matchCategories(rules, match, p); !reflect.DeepEqual(got, []string{"remake"}) {
+		t.Errorf("matchCategories of a remake = %v, want [remake]", got)
+	}
+}
+
+func TestRuleConditions(t *testing.T) {
+	match := loadMatch(t, "EUW1_6612345678")
+
+	tests := []struct {
+		player string
+		when   string
+		result string
+		want   bool
+	}{
+		{"Keko", "pentaKills > 0", "", true},
+		{"Keko", "kda >= 8", "", true},
+		{"Keko", "highestTeamDamage", "", true},
+		{"Gibe", "firstBloodKill", "", true},
+		{"Gibe", "firstBloodKill == false", "", false},
+		{"Enemy3", "challenges.killParticipation < 0.3", "", true},
+		{"Enemy3", "kp < 30", "", true},
+		{"Enemy4", "lowestTeamDamage", "", true},
+		{"Rodri", "lowestTeamDamage", "", true},
+		{"Rodri", "lowestTeamDamage", "loss", false},
+		{"Enemy2", "pings > 50", "loss", true},
+		{"Enemy2", "cspermin > 6", "", true},
+		{"Enemy2", "minutes == 31", "win", false},
+	}
+
+	for _, tt := range tests {
+		rules, err := parseRules([]byte(`[{"name": "test", "result": "` + tt.result + `", "when": ["` + tt.when + `"], "category": "win"}]`))
+		if err != nil {
+			t.Fatalf("%s: %s", tt.when, err)
+		}
+
+		if got := rules[0].Matches(match, participantByName(t, match, tt.player)); got != tt.want {
+			t.Errorf("%s %q (result %q) = %v, want %v", tt.player, tt.when, tt.result, got, tt.want)
+		}
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	tests := map[string]string{
+		"no category":      `[{"name": "x", "when": ["deaths > 1"]}]`,
+		"unknown category": `[{"name": "x", "when": ["deaths > 1"], "category": "nope"}]`,
+		"unknown field":    `[{"name": "x", "when": ["dethz > 1"], "category": "loss"}]`,
+		"bad operator":     `[{"name": "x", "when": ["deaths => 1"], "category": "loss"}]`,
+		"bad result":       `[{"name": "x", "result": "draw", "when": ["deaths > 1"], "category": "loss"}]`,
+	}
+
+	for name, content := range tests {
+		if _, err := parseRules([]byte(content)); err == nil {
+			t.Errorf("%s: parseRules didn't fail", name)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"unicode/utf8"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	scorecardWidth  = 600
+	scorecardHeight = 264
+	scorecardBanner = 56
+	scorecardIcon   = 104
+	scorecardItem   = 52
+)
+
+var (
+	scorecardWin     = color.RGBA{0x1f, 0x8a, 0x4c, 0xff}
+	scorecardLoss    = color.RGBA{0xb0, 0x2e, 0x2e, 0xff}
+	scorecardSlot    = color.RGBA{0x2c, 0x30, 0x38, 0xff}
+	scorecardSubtext = color.RGBA{0x9a, 0xa0, 0xaa, 0xff}
+)
+
+// renderScorecard draws the card of a tracked player: a win or loss banner,
+// the champion with its stats and the final items. Icons come from the
+// Data Dragon bundle and missing ones are left as empty slots, so it renders
+// with whatever is cached.
+func renderScorecard(data AnnouncementData, player PlayerData, ddragon *DataDragon) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, scorecardWidth, scorecardHeight))
+	draw.Draw(img, img.Bounds(), &image.Uniform{chartBackground}, image.Point{}, draw.Src)
+
+	banner := scorecardLoss
+	if player.Win {
+		banner = scorecardWin
+	}
+	draw.Draw(img, image.Rect(0, 0, scorecardWidth, scorecardBanner), &image.Uniform{banner}, image.Point{}, draw.Src)
+	drawTextScaled(img, 20, 12, player.Result, color.White, 3)
+	drawText(img, scorecardWidth-20-textWidth(data.Queue), 24, data.Queue, color.White)
+	duration := fmt.Sprintf("%d min", data.Duration)
+	drawText(img, scorecardWidth-20-textWidth(duration), 40, duration, color.White)
+
+	top := scorecardBanner + 16
+	iconRect := image.Rect(20, top, 20+scorecardIcon, top+scorecardIcon)
+	drawIcon(img, iconRect, ddragon.ChampionIcon(player.ChampionName))
+
+	x := iconRect.Max.X + 20
+	champion := ddragon.ChampionName(player.ChampionID)
+	if champion == strconv.Itoa(player.ChampionID) {
+		// without the bundle the match name is better than the id
+		champion = player.ChampionName
+	}
+	drawTextScaled(img, x, top, champion, color.White, 2)
+	drawText(img, x, top+40, player.Account.RiotId(), scorecardSubtext)
+
+	stats := []string{
+		fmt.Sprintf("KDA %d/%d/%d (%.1f)", player.Kills, player.Deaths, player.Assists, player.KDA),
+		fmt.Sprintf("CS/min %.1f", player.CSPerMin),
+		fmt.Sprintf("Dano %.0f%%", player.DamageShare),
+		fmt.Sprintf("Vision %d", player.VisionScore),
+	}
+	for i, stat := range stats {
+		// two columns of two lines
+		drawTextScaled(img, x+(i/2)*270, top+56+(i%2)*26, stat, color.White, 2)
+	}
+
+	itemsTop := iconRect.Max.Y + 16
+	items := []int{player.Item0, player.Item1, player.Item2, player.Item3, player.Item4, player.Item5, player.Item6}
+	for i, item := range items {
+		slot := image.Rect(20+i*(scorecardItem+8), itemsTop, 20+i*(scorecardItem+8)+scorecardItem, itemsTop+scorecardItem)
+		draw.Draw(img, slot, &image.Uniform{scorecardSlot}, image.Point{}, draw.Src)
+
+		// 0 is an empty slot
+		if item != 0 {
+			drawIcon(img, slot, ddragon.ItemIcon(item))
+		}
+	}
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// drawIcon scales an icon into r, leaving r as it is when icon is nil.
+func drawIcon(img *image.RGBA, r image.Rectangle, icon image.Image) {
+	if icon == nil {
+		draw.Draw(img, r, &image.Uniform{scorecardSlot}, image.Point{}, draw.Src)
+		return
+	}
+
+	xdraw.CatmullRom.Scale(img, r, icon, icon.Bounds(), xdraw.Over, nil)
+}
+
+// drawTextScaled writes text scale times bigger than drawText, y is the top
+// of the text.
+func drawTextScaled(img *image.RGBA, x, y int, text string, c color.Color, scale int) {
+	small := image.NewRGBA(image.Rect(0, 0, textWidth(text), 13))
+	drawText(small, 0, 10, text, c)
+
+	r := image.Rect(x, y, x+small.Bounds().Dx()*scale, y+small.Bounds().Dy()*scale)
+	xdraw.NearestNeighbor.Scale(img, r, small, small.Bounds(), xdraw.Over, nil)
+}
+
+// textWidth is the width of text in pixels with the basic font, which only
+// has ASCII and draws a box for the rest.
+func textWidth(text string) int {
+	return 7 * utf8.RuneCountInString(text)
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestRenderScorecard(t *testing.T) {
+	ddragon := testDataDragon(t, notFound)
+	match := loadMatch(t, "EUW1_6612345678")
+
+	players := []*trackedPlayer{
+		{account: Account{Puuid: "puuid-keko", GameName: "Keko", TagLine: "EUW"}},
+		{account: Account{Puuid: "puuid-enemy1", GameName: "Enemy1", TagLine: "EUW"}},
+	}
+	noPhrase := func(match Match, acc Account, p Participant) string { return "" }
+	data := newAnnouncementData(match, nil, players, nil, noPhrase)
+
+	for _, player := range data.Players {
+		card, err := renderScorecard(data, player, ddragon)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden(t, "scorecard_EUW1_6612345678_"+player.Account.GameName+".png", card)
+	}
+}
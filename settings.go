@@ -0,0 +1,22 @@
+package main
+
+import "go.mau.fi/whatsmeow/types"
+
+// groupSettings returns the settings of a group, creating the defaults the
+// first time.
+func (c *LeviClient) groupSettings(group types.JID) GroupSettings {
+	settings := GroupSettings{GroupJID: group.String()}
+	c.db.Where(GroupSettings{GroupJID: settings.GroupJID}).FirstOrCreate(&settings)
+
+	return settings
+}
+
+// shouldAnnounce checks the queue of a game against the group filter and
+// the queues the group muted for the account.
+func (c *LeviClient) shouldAnnounce(group types.JID, sub Subscription, queueId int) bool {
+	if sub.MutedQueues.Matches(queueId) {
+		return false
+	}
+
+	return c.groupSettings(group).QueueFilter.Allows(queueId)
+}
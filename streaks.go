@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// Streak lengths that get their own message, after the last one every
+// streakStep games are announced too.
+var streakThresholds = []int{3, 5, 7, 10}
+
+const streakStep = 5
+
+// Streaks at least this long get a callout when they end.
+const longStreak = 5
+
+// StreakChange is the streak of an account before and after a match.
+type StreakChange struct {
+	QueueId int
+	Before  int
+	After   int
+}
+
+// updateStreak adds the result of the match to the streak of the account
+// in its queue. Remakes don't count.
+func (c *LeviClient) updateStreak(acc Account, match Match) *StreakChange {
+	for _, p := range match.Info.Participants {
+		if p.Puuid != acc.Puuid {
+			continue
+		}
+
+		if classifyMatch(match, p) == OutcomeRemake {
+			return nil
+		}
+
+		streak := Streak{Puuid: acc.Puuid, QueueId: match.Info.QueueID}
+		c.db.Where(Streak{Puuid: acc.Puuid, QueueId: match.Info.QueueID}).FirstOrCreate(&streak)
+
+		change := &StreakChange{QueueId: streak.QueueId, Before: streak.Count}
+
+		switch {
+		case p.Win && streak.Count > 0:
+			streak.Count++
+		case p.Win:
+			streak.Count = 1
+		case streak.Count < 0:
+			streak.Count--
+		default:
+			streak.Count = -1
+		}
+
+		c.db.Model(&streak).Update("count", streak.Count)
+		change.After = streak.Count
+
+		return change
+	}
+
+	return nil
+}
+
+func reachedStreakThreshold(count int) bool {
+	for _, threshold := range streakThresholds {
+		if count == threshold {
+			return true
+		}
+	}
+
+	last := streakThresholds[len(streakThresholds)-1]
+	return count > last && (count-last)%streakStep == 0
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// formatStreak returns "racha de 4 victorias" or "3 derrotas seguidas".
+func formatStreak(count int) string {
+	if count > 0 {
+		return fmt.Sprintf("racha de %d victorias", count)
+	}
+
+	return fmt.Sprintf("%d derrotas seguidas", -count)
+}
+
+// announceStreakChange sends the streak milestone and streak ending
+// messages of a match.
+func (c *LeviClient) announceStreakChange(group types.JID, acc Account, change *StreakChange) {
+	queue := queueName(change.QueueId)
+	brokeStreak := change.Before != 0 && (change.Before > 0) != (change.After > 0)
+
+	if brokeStreak && abs(change.Before) >= longStreak {
+		if change.Before > 0 {
+			c.SendText(group, fmt.Sprintf(
+				"Bot: Se acabo la fiesta, %s corta su racha de %d victorias en %s",
+				acc.RiotId(),
+				change.Before,
+				queue,
+			))
+		} else {
+			c.SendText(group, fmt.Sprintf(
+				"Bot: Por fin! %s gana en %s despues de %d derrotas seguidas",
+				acc.RiotId(),
+				queue,
+				-change.Before,
+			))
+		}
+	}
+
+	if !reachedStreakThreshold(abs(change.After)) {
+		return
+	}
+
+	if change.After > 0 {
+		msg := fmt.Sprintf("Bot: 🔥 %s lleva una %s en %s!", acc.RiotId(), formatStreak(change.After), queue)
+		c.SendText(group, c.withPhrase(group, msg, "streak", acc))
+	} else {
+		c.SendText(group, fmt.Sprintf("Bot: 💀 %s lleva %s en %s, que alguien le pare", acc.RiotId(), formatStreak(change.After), queue))
+	}
+}
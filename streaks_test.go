@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestReachedStreakThreshold(t *testing.T) {
+	tests := []struct {
+		count   int
+		reached bool
+	}{
+		{1, false},
+		{2, false},
+		{3, true},
+		{4, false},
+		{5, true},
+		{7, true},
+		{9, false},
+		{10, true},
+		{12, false},
+		{15, true},
+		{20, true},
+		{21, false},
+	}
+
+	for _, tt := range tests {
+		if got := reachedStreakThreshold(tt.count); got != tt.reached {
+			t.Errorf("reachedStreakThreshold(%d) = %v, want %v", tt.count, got, tt.reached)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"sort"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// subscribe makes the group follow the account, returns false when it
+// already did.
+func (c *LeviClient) subscribe(group types.JID, acc Account) bool {
+	if _, found := c.subscription(group, acc); found {
+		return false
+	}
+
+	return c.db.Create(&Subscription{GroupJID: group.String(), Puuid: acc.Puuid}).Error == nil
+}
+
+// unsubscribe stops the group following the account and returns how many
+// groups still follow it.
+func (c *LeviClient) unsubscribe(group types.JID, acc Account) int64 {
+	c.db.Unscoped().Where(&Subscription{GroupJID: group.String(), Puuid: acc.Puuid}).Delete(&Subscription{})
+
+	var remaining int64
+	c.db.Model(&Subscription{}).Where("puuid = ?", acc.Puuid).Count(&remaining)
+
+	return remaining
+}
+
+// subscription returns the subscription of the group to the account.
+func (c *LeviClient) subscription(group types.JID, acc Account) (Subscription, bool) {
+	var sub Subscription
+	found := c.db.Where(&Subscription{GroupJID: group.String(), Puuid: acc.Puuid}).Limit(1).Find(&sub).RowsAffected > 0
+
+	return sub, found
+}
+
+// groupAccounts returns the accounts followed by the group, by name.
+func (c *LeviClient) groupAccounts(group types.JID) []Account {
+	var accs []Account
+	c.db.
+		Where("puuid IN (?)", c.db.Model(&Subscription{}).Select("puuid").Where(&Subscription{GroupJID: group.String()})).
+		Order("game_name, name").
+		Find(&accs)
+
+	return accs
+}
+
+// followers returns the groups following the account.
+func (c *LeviClient) followers(acc Account) []types.JID {
+	var subs []Subscription
+	c.db.Where("puuid = ?", acc.Puuid).Find(&subs)
+
+	var groups []types.JID
+	for _, sub := range subs {
+		if group, err := types.ParseJID(sub.GroupJID); err == nil {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// subscribedGroups returns every group following at least one account.
+func (c *LeviClient) subscribedGroups() []types.JID {
+	var subs []Subscription
+	c.db.Order("group_j_id").Find(&subs)
+
+	var groups []types.JID
+	for i, sub := range subs {
+		if i > 0 && sub.GroupJID == subs[i-1].GroupJID {
+			continue
+		}
+		if group, err := types.ParseJID(sub.GroupJID); err == nil {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// matchSubscriptions groups by chat the subscriptions of the players of a
+// match, sorted so groups are announced in a stable order.
+func (c *LeviClient) matchSubscriptions(players []*trackedPlayer) ([]string, map[string][]Subscription) {
+	var puuids []string
+	for _, player := range players {
+		puuids = append(puuids, player.account.Puuid)
+	}
+
+	var subs []Subscription
+	c.db.Where("puuid IN ?", puuids).Find(&subs)
+
+	byGroup := map[string][]Subscription{}
+	for _, sub := range subs {
+		byGroup[sub.GroupJID] = append(byGroup[sub.GroupJID], sub)
+	}
+
+	groups := make([]string, 0, len(byGroup))
+	for group := range byGroup {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	return groups, byGroup
+}
+
+// migrateSubscriptions subscribes the GROUP of older versions, which only
+// had one group, to every account the first time the bot runs with
+// subscriptions. The queues muted per account are kept for that group.
+func (c *LeviClient) migrateSubscriptions(group types.JID) {
+	var count int64
+	c.db.Model(&Subscription{}).Count(&count)
+
+	if count > 0 || group.IsEmpty() {
+		return
+	}
+
+	muted := map[string]string{}
+	if c.db.Migrator().HasColumn(&Account{}, "muted_queues") {
+		var rows []struct {
+			Puuid       string
+			MutedQueues string
+		}
+		c.db.Table("accounts").Select("puuid, muted_queues").Find(&rows)
+
+		for _, row := range rows {
+			muted[row.Puuid] = row.MutedQueues
+		}
+	}
+
+	var accs []Account
+	c.db.Find(&accs)
+
+	for _, acc := range accs {
+		c.db.Create(&Subscription{
+			GroupJID:    group.String(),
+			Puuid:       acc.Puuid,
+			MutedQueues: QueueFilter(muted[acc.Puuid]),
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestSubscribeTwice(t *testing.T) {
+	c := newTestClient(t)
+	acc := Account{Name: "Keko", Puuid: "puuid-keko"}
+	group := types.NewJID("123456", types.GroupServer)
+	other := types.NewJID("654321", types.GroupServer)
+
+	if !c.subscribe(group, acc) {
+		t.Fatal("first subscribe returned false")
+	}
+	if c.subscribe(group, acc) {
+		t.Fatal("second subscribe of the same group returned true")
+	}
+	if !c.subscribe(other, acc) {
+		t.Fatal("subscribe of another group returned false")
+	}
+
+	var count int64
+	c.db.Model(&Subscription{}).Where("puuid = ?", acc.Puuid).Count(&count)
+	if count != 2 {
+		t.Fatalf("got %d subscriptions, want 2", count)
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const (
+	defaultTemplate  = "default"
+	templateFileExt  = ".tmpl"
+	defaultTemplates = "templates"
+)
+
+// The default template is compiled in so the bot works without the
+// templates directory, a file with the same name on disk takes precedence.
+//
+//go:embed templates/default.tmpl
+var embeddedTemplates embed.FS
+
+// Every template file has to define these blocks.
+var templateBlocks = []string{"win", "loss", "remake", "group"}
+
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"pct": func(v float64) string {
+		return fmt.Sprintf("%.0f%%", v)
+	},
+	"decimal": func(v float64) string {
+		return fmt.Sprintf("%.1f", v)
+	},
+	"gold": formatGoldDiff,
+	"names": func(players []PlayerData) string {
+		var names []string
+		for _, p := range players {
+			names = append(names, p.DisplayName())
+		}
+		return strings.Join(names, ", ")
+	},
+}
+
+type loadedTemplate struct {
+	tmpl    *template.Template
+	modTime time.Time
+	// err is why the file of modTime didn't parse, tmpl is then the last
+	// good version
+	err error
+}
+
+// Templates loads the announcement templates from a directory, files are
+// parsed again whenever they change on disk so edits don't need a restart.
+type Templates struct {
+	mu     sync.Mutex
+	dir    string
+	loaded map[string]loadedTemplate
+}
+
+func NewTemplates(dir string) *Templates {
+	if dir == "" {
+		dir = defaultTemplates
+	}
+
+	return &Templates{dir: dir, loaded: map[string]loadedTemplate{}}
+}
+
+// Render executes a block of the named template. When the template is
+// broken or fails to render the default one is used, and the compiled in
+// default after that, so a bad edit never leaves a group without its
+// announcements.
+func (t *Templates) Render(name, block string, data interface{}) (string, error) {
+	if name == "" {
+		name = defaultTemplate
+	}
+
+	candidates := []string{name, defaultTemplate, ""}
+	if name == defaultTemplate {
+		candidates = candidates[1:]
+	}
+
+	var firstErr error
+	for _, candidate := range candidates {
+		// a broken file still returns its last good version
+		tmpl, err := t.get(candidate)
+
+		if tmpl == nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		var out bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&out, block, data); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		return strings.TrimSpace(out.String()), nil
+	}
+
+	return "", firstErr
+}
+
+// Exists reports whether there is a template with that name.
+func (t *Templates) Exists(name string) bool {
+	for _, n := range t.Names() {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Names lists the templates available on disk plus the built in default.
+func (t *Templates) Names() []string {
+	names := []string{defaultTemplate}
+
+	files, _ := filepath.Glob(filepath.Join(t.dir, "*"+templateFileExt))
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), templateFileExt)
+		if name != defaultTemplate {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Reload parses every template again, returning the errors of the ones
+// that are broken. Those keep their last good version.
+func (t *Templates) Reload() error {
+	t.mu.Lock()
+	for name, loaded := range t.loaded {
+		// the embedded default never changes
+		if name != "" {
+			t.loaded[name] = loadedTemplate{tmpl: loaded.tmpl}
+		}
+	}
+	t.mu.Unlock()
+
+	var errs []string
+	for _, name := range t.Names() {
+		if _, err := t.get(name); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+// get returns the named template, "" is the embedded default.
+func (t *Templates) get(name string) (*template.Template, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if name == "" {
+		return t.embedded()
+	}
+
+	return t.load(name)
+}
+
+// load parses the template if it changed since the last time, the lock must
+// be held. A file that fails to parse returns the error together with its
+// last good version, if there was one.
+func (t *Templates) load(name string) (*template.Template, error) {
+	path := filepath.Join(t.dir, filepath.Base(name)+templateFileExt)
+	info, err := os.Stat(path)
+
+	if err != nil {
+		if name != defaultTemplate {
+			return t.load(defaultTemplate)
+		}
+		return t.embedded()
+	}
+
+	if loaded, ok := t.loaded[name]; ok && loaded.modTime.Equal(info.ModTime()) {
+		return loaded.tmpl, loaded.err
+	}
+
+	tmpl, err := parseTemplate(name, func() ([]byte, error) { return os.ReadFile(path) })
+
+	if err != nil {
+		// keep announcing with the previous version until it gets fixed, the
+		// broken file isn't parsed again until it changes
+		t.loaded[name] = loadedTemplate{t.loaded[name].tmpl, info.ModTime(), err}
+		return t.loaded[name].tmpl, err
+	}
+
+	t.loaded[name] = loadedTemplate{tmpl, info.ModTime(), nil}
+	return tmpl, nil
+}
+
+func (t *Templates) embedded() (*template.Template, error) {
+	if loaded, ok := t.loaded[""]; ok {
+		return loaded.tmpl, nil
+	}
+
+	tmpl, err := parseTemplate(defaultTemplate, func() ([]byte, error) {
+		return fs.ReadFile(embeddedTemplates, "templates/default.tmpl")
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	t.loaded[""] = loadedTemplate{tmpl: tmpl}
+	return tmpl, nil
+}
+
+func parseTemplate(name string, read func() ([]byte, error)) (*template.Template, error) {
+	content, err := read()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(string(content))
+
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+
+	for _, block := range templateBlocks {
+		if tmpl.Lookup(block) == nil {
+			return nil, fmt.Errorf("template %s: missing block %q", name, block)
+		}
+	}
+
+	return tmpl, nil
+}
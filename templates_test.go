@@ -0,0 +1,102 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTemplate writes a template file defining every block as text, with
+// a new modification time so it's picked up as changed.
+func writeTemplate(t *testing.T, dir, name, content string, age time.Duration) {
+	path := filepath.Join(dir, name+templateFileExt)
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	modTime := time.Now().Add(-age)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func blocks(text string) string {
+	var b strings.Builder
+	for _, block := range templateBlocks {
+		b.WriteString(`{{define "` + block + `"}}` + text + `{{end}}`)
+	}
+	return b.String()
+}
+
+func TestTemplatesKeepLastGoodVersion(t *testing.T) {
+	dir := t.TempDir()
+	templates := NewTemplates(dir)
+
+	writeTemplate(t, dir, "custom", blocks("v1"), time.Hour)
+
+	if got, err := templates.Render("custom", "win", nil); err != nil || got != "v1" {
+		t.Fatalf("Render = %q, %v", got, err)
+	}
+
+	writeTemplate(t, dir, "custom", `{{define "win"}}{{if}}{{end}}`, 0)
+
+	if err := templates.Reload(); err == nil {
+		t.Fatal("Reload of a broken template didn't fail")
+	}
+
+	if got, err := templates.Render("custom", "win", nil); err != nil || got != "v1" {
+		t.Fatalf("Render after a broken edit = %q, %v, want the last good version", got, err)
+	}
+}
+
+func TestTemplatesFallBackToDefault(t *testing.T) {
+	dir := t.TempDir()
+	templates := NewTemplates(dir)
+
+	writeTemplate(t, dir, defaultTemplate, blocks("default"), time.Hour)
+	// parses fine but fails when executed
+	writeTemplate(t, dir, "fails", blocks("{{.Missing}}"), time.Hour)
+	writeTemplate(t, dir, "broken", `{{define "win"}}{{if}}{{end}}`, time.Hour)
+
+	for _, name := range []string{"fails", "broken", "unknown"} {
+		if got, err := templates.Render(name, "win", struct{}{}); err != nil || got != "default" {
+			t.Errorf("Render(%q) = %q, %v, want the default template", name, got, err)
+		}
+	}
+}
+
+func TestTemplatesParseBrokenFileOnce(t *testing.T) {
+	dir := t.TempDir()
+	templates := NewTemplates(dir)
+
+	writeTemplate(t, dir, "custom", `{{define "win"}}{{if}}{{end}}`, time.Hour)
+
+	if _, err := templates.get("custom"); err == nil {
+		t.Fatal("get of a broken template didn't fail")
+	}
+
+	path := filepath.Join(dir, "custom"+templateFileExt)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// same modification time, a second parse would pick up the new content
+	writeTemplate(t, dir, "custom", blocks("fixed"), time.Hour)
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := templates.get("custom"); err == nil {
+		t.Fatal("the broken template was parsed again without changing")
+	}
+
+	writeTemplate(t, dir, "custom", blocks("fixed"), 0)
+
+	if got, err := templates.Render("custom", "win", nil); err != nil || got != "fixed" {
+		t.Fatalf("Render after fixing the file = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Event types of the match-v5 timeline that get a typed Data.
+const (
+	EventChampionKill     = "CHAMPION_KILL"
+	EventItemPurchased    = "ITEM_PURCHASED"
+	EventWardPlaced       = "WARD_PLACED"
+	EventLevelUp          = "LEVEL_UP"
+	EventEliteMonsterKill = "ELITE_MONSTER_KILL"
+	EventBuildingKill     = "BUILDING_KILL"
+)
+
+// A team has to be this much gold ahead for losing to count as a throw.
+const throwGoldLead = 5000
+
+type MatchTimeline struct {
+	Metadata struct {
+		DataVersion  string   `json:"dataVersion"`
+		MatchID      string   `json:"matchId"`
+		Participants []string `json:"participants"`
+	} `json:"metadata"`
+	Info struct {
+		FrameInterval int64           `json:"frameInterval"`
+		Frames        []TimelineFrame `json:"frames"`
+		GameID        int64           `json:"gameId"`
+		Participants  []struct {
+			ParticipantID int    `json:"participantId"`
+			Puuid         string `json:"puuid"`
+		} `json:"participants"`
+	} `json:"info"`
+}
+
+// TimelineFrame is a snapshot of every participant, taken once a minute,
+// with the events that happened since the previous one.
+type TimelineFrame struct {
+	Timestamp int64 `json:"timestamp"`
+	// ParticipantFrames are keyed by participant id, "1" to "10"
+	ParticipantFrames map[string]ParticipantFrame `json:"participantFrames"`
+	Events            []TimelineEvent             `json:"events"`
+}
+
+type ParticipantFrame struct {
+	ParticipantID            int      `json:"participantId"`
+	CurrentGold              int      `json:"currentGold"`
+	TotalGold                int      `json:"totalGold"`
+	GoldPerSecond            int      `json:"goldPerSecond"`
+	Level                    int      `json:"level"`
+	Xp                       int      `json:"xp"`
+	MinionsKilled            int      `json:"minionsKilled"`
+	JungleMinionsKilled      int      `json:"jungleMinionsKilled"`
+	TimeEnemySpentControlled int      `json:"timeEnemySpentControlled"`
+	Position                 Position `json:"position"`
+}
+
+type Position struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// TimelineEvent is any event of a frame. Data holds one of the *Event types
+// below for the types listed above, and is nil for the rest.
+type TimelineEvent struct {
+	Type      string      `json:"type"`
+	Timestamp int64       `json:"timestamp"`
+	Data      interface{} `json:"-"`
+}
+
+type ChampionKillEvent struct {
+	Timestamp               int64    `json:"timestamp"`
+	KillerID                int      `json:"killerId"`
+	VictimID                int      `json:"victimId"`
+	AssistingParticipantIDs []int    `json:"assistingParticipantIds"`
+	Bounty                  int      `json:"bounty"`
+	ShutdownBounty          int      `json:"shutdownBounty"`
+	KillStreakLength        int      `json:"killStreakLength"`
+	Position                Position `json:"position"`
+}
+
+type ItemPurchasedEvent struct {
+	Timestamp     int64 `json:"timestamp"`
+	ParticipantID int   `json:"participantId"`
+	ItemID        int   `json:"itemId"`
+}
+
+type WardPlacedEvent struct {
+	Timestamp int64  `json:"timestamp"`
+	CreatorID int    `json:"creatorId"`
+	WardType  string `json:"wardType"`
+}
+
+type LevelUpEvent struct {
+	Timestamp     int64 `json:"timestamp"`
+	ParticipantID int   `json:"participantId"`
+	Level         int   `json:"level"`
+}
+
+type EliteMonsterKillEvent struct {
+	Timestamp               int64    `json:"timestamp"`
+	KillerID                int      `json:"killerId"`
+	KillerTeamID            int      `json:"killerTeamId"`
+	MonsterType             string   `json:"monsterType"`
+	MonsterSubType          string   `json:"monsterSubType"`
+	AssistingParticipantIDs []int    `json:"assistingParticipantIds"`
+	Position                Position `json:"position"`
+}
+
+type BuildingKillEvent struct {
+	Timestamp               int64    `json:"timestamp"`
+	KillerID                int      `json:"killerId"`
+	TeamID                  int      `json:"teamId"`
+	BuildingType            string   `json:"buildingType"`
+	LaneType                string   `json:"laneType"`
+	TowerType               string   `json:"towerType"`
+	Bounty                  int      `json:"bounty"`
+	AssistingParticipantIDs []int    `json:"assistingParticipantIds"`
+	Position                Position `json:"position"`
+}
+
+var timelineEventTypes = map[string]func() interface{}{
+	EventChampionKill:     func() interface{} { return &ChampionKillEvent{} },
+	EventItemPurchased:    func() interface{} { return &ItemPurchasedEvent{} },
+	EventWardPlaced:       func() interface{} { return &WardPlacedEvent{} },
+	EventLevelUp:          func() interface{} { return &LevelUpEvent{} },
+	EventEliteMonsterKill: func() interface{} { return &EliteMonsterKillEvent{} },
+	EventBuildingKill:     func() interface{} { return &BuildingKillEvent{} },
+}
+
+func (e *TimelineEvent) UnmarshalJSON(b []byte) error {
+	var header struct {
+		Type      string `json:"type"`
+		Timestamp int64  `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal(b, &header); err != nil {
+		return err
+	}
+
+	e.Type, e.Timestamp, e.Data = header.Type, header.Timestamp, nil
+
+	newData, ok := timelineEventTypes[header.Type]
+	if !ok {
+		return nil
+	}
+
+	data := newData()
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("timeline event %s: %w", header.Type, err)
+	}
+
+	e.Data = data
+	return nil
+}
+
+// ChampionKills returns every kill of the game in order.
+func (t MatchTimeline) ChampionKills() []*ChampionKillEvent {
+	var kills []*ChampionKillEvent
+
+	for _, frame := range t.Info.Frames {
+		for _, event := range frame.Events {
+			if kill, ok := event.Data.(*ChampionKillEvent); ok {
+				kills = append(kills, kill)
+			}
+		}
+	}
+
+	return kills
+}
+
+// GoldDiffs returns, for every frame, the total gold of a team minus the
+// gold of the other one.
+func (t MatchTimeline) GoldDiffs(match Match, teamId int) []int {
+	return t.teamDiffs(match, teamId, func(p ParticipantFrame) int { return p.TotalGold })
+}
+
+// XpDiffs is GoldDiffs for experience.
+func (t MatchTimeline) XpDiffs(match Match, teamId int) []int {
+	return t.teamDiffs(match, teamId, func(p ParticipantFrame) int { return p.Xp })
+}
+
+func (t MatchTimeline) teamDiffs(match Match, teamId int, value func(ParticipantFrame) int) []int {
+	teams := map[string]int{}
+	for _, p := range match.Info.Participants {
+		teams[fmt.Sprint(p.ParticipantID)] = p.TeamID
+	}
+
+	diffs := make([]int, 0, len(t.Info.Frames))
+	for _, frame := range t.Info.Frames {
+		diff := 0
+		for id, p := range frame.ParticipantFrames {
+			if teams[id] == teamId {
+				diff += value(p)
+			} else {
+				diff -= value(p)
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+// PlayerTimeline is what the timeline tells about a player's game.
+type PlayerTimeline struct {
+	// GoldDiff10 and GoldDiff15 are the team gold lead at 10 and 15 minutes,
+	// negative when behind. Has10 and Has15 are false for games shorter
+	// than that.
+	GoldDiff10 int
+	GoldDiff15 int
+	Has10      bool
+	Has15      bool
+	// FirstDeath is the time of the first death like "4:32", empty when
+	// the player never died
+	FirstDeath string
+	// MaxGoldLead is the biggest team gold lead of the game
+	MaxGoldLead int
+	// Threw is true when the team was throwGoldLead ahead and still lost
+	Threw bool
+}
+
+// playerTimeline computes the timeline stats of a participant.
+func playerTimeline(timeline MatchTimeline, match Match, p Participant) PlayerTimeline {
+	var stats PlayerTimeline
+
+	diffs := timeline.GoldDiffs(match, p.TeamID)
+	frameAt := func(minute int) int {
+		interval := timeline.Info.FrameInterval
+		if interval == 0 {
+			interval = 60000
+		}
+		return int(int64(minute) * 60000 / interval)
+	}
+
+	if i := frameAt(10); i < len(diffs) {
+		stats.GoldDiff10 = diffs[i]
+		stats.Has10 = true
+	}
+
+	if i := frameAt(15); i < len(diffs) {
+		stats.GoldDiff15 = diffs[i]
+		stats.Has15 = true
+	}
+
+	for _, diff := range diffs {
+		if diff > stats.MaxGoldLead {
+			stats.MaxGoldLead = diff
+		}
+	}
+
+	stats.Threw = !p.Win && stats.MaxGoldLead >= throwGoldLead
+
+	for _, kill := range timeline.ChampionKills() {
+		if kill.VictimID == p.ParticipantID {
+			seconds := kill.Timestamp / 1000
+			stats.FirstDeath = fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+			break
+		}
+	}
+
+	return stats
+}
+
+// matchTimeline fetches the timeline for the announcements, nil when the
+// game doesn't need one or Riot fails to give it.
+func (c *LeviClient) matchTimeline(match Match) *MatchTimeline {
+	// remakes are announced without stats
+	if len(match.Info.Participants) == 0 || classifyMatch(match, match.Info.Participants[0]) == OutcomeRemake {
+		return nil
+	}
+
+	timeline, err := c.lolClient.GetMatchTimeline(match.Metadata.MatchID)
+
+	if err != nil {
+		c.wppClient.Log.Errorf("Could not get the timeline of %s: %s", match.Metadata.MatchID, err)
+		return nil
+	}
+
+	return &timeline
+}
+
+// formatGoldDiff returns gold like "+1.2k" or "-350".
+func formatGoldDiff(gold int) string {
+	sign := "+"
+	if gold < 0 {
+		sign = "-"
+		gold = -gold
+	}
+
+	if gold >= 1000 {
+		return fmt.Sprintf("%s%.1fk", sign, float64(gold)/1000)
+	}
+
+	return fmt.Sprintf("%s%d", sign, gold)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// goldTimeline makes a timeline of minutes frames where each player of
+// team 100 earns 100 gold a minute more than the ones of team 200.
+func goldTimeline(match Match, minutes int) MatchTimeline {
+	var timeline MatchTimeline
+	timeline.Info.FrameInterval = 60000
+
+	for minute := 0; minute <= minutes; minute++ {
+		frame := TimelineFrame{
+			Timestamp:         int64(minute) * 60000,
+			ParticipantFrames: map[string]ParticipantFrame{},
+		}
+
+		for _, p := range match.Info.Participants {
+			gold := 500 + 300*minute
+			if p.TeamID == 100 {
+				gold += 100 * minute
+			}
+			frame.ParticipantFrames[fmt.Sprint(p.ParticipantID)] = ParticipantFrame{ParticipantID: p.ParticipantID, TotalGold: gold}
+		}
+
+		timeline.Info.Frames = append(timeline.Info.Frames, frame)
+	}
+
+	return timeline
+}
+
+func TestPlayerTimelineGoldDiffs(t *testing.T) {
+	match := loadMatch(t, "EUW1_6612345678")
+
+	tests := []struct {
+		minutes    int
+		player     string
+		has10      bool
+		has15      bool
+		goldDiff10 int
+		goldDiff15 int
+	}{
+		{8, "Keko", false, false, 0, 0},
+		{12, "Keko", true, false, 5000, 0},
+		{20, "Keko", true, true, 5000, 7500},
+		{20, "Enemy1", true, true, -5000, -7500},
+	}
+
+	for _, tt := range tests {
+		stats := playerTimeline(goldTimeline(match, tt.minutes), match, participantByName(t, match, tt.player))
+
+		if stats.Has10 != tt.has10 || stats.Has15 != tt.has15 || stats.GoldDiff10 != tt.goldDiff10 || stats.GoldDiff15 != tt.goldDiff15 {
+			t.Errorf("%s at %d minutes = %+v, want Has10 %v Has15 %v %d %d", tt.player, tt.minutes, stats, tt.has10, tt.has15, tt.goldDiff10, tt.goldDiff15)
+		}
+	}
+}
+
+func TestDefaultTemplateSkipsMissingGoldDiffs(t *testing.T) {
+	templates := NewTemplates(t.TempDir())
+	match := loadMatch(t, "EUW1_6612345678")
+	p := participantByName(t, match, "Keko")
+
+	tests := []struct {
+		minutes int
+		gold    string
+	}{
+		{8, ""},
+		{12, "ORO DEL EQUIPO: +5.0k a los 10"},
+		{20, "ORO DEL EQUIPO: +5.0k a los 10, +7.5k a los 15"},
+	}
+
+	for _, tt := range tests {
+		stats := playerTimeline(goldTimeline(match, tt.minutes), match, p)
+		data := AnnouncementData{Match: match, Players: []PlayerData{{Participant: p, Timeline: &stats}}}
+
+		got, err := templates.Render(defaultTemplate, "win", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Contains(got, "ORO DEL EQUIPO") != (tt.gold != "") || !strings.HasSuffix(got, tt.gold) {
+			t.Errorf("%d minutes rendered %q, want %q", tt.minutes, got, tt.gold)
+		}
+	}
+}